@@ -26,13 +26,17 @@ func main() {
 		os.Exit(1)
 	}
 
-	gatewayManager, err := gateways.NewManager(cfg)
+	gatewayManager, err := gateways.NewManager(appCtx, cfg)
 	if err != nil {
 		slog.Error("Failed to initializate gateway manager", "error", err)
 		os.Exit(1)
 	}
 
-	srv := server.NewServer(gatewayManager, cfg)
+	srv, err := server.NewServer(gatewayManager, cfg)
+	if err != nil {
+		slog.Error("Failed to initializate API server", "error", err)
+		os.Exit(1)
+	}
 	httpServer := &http.Server{
 		Addr:    ":5555",
 		Handler: srv.Router(),
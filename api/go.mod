@@ -5,7 +5,6 @@ go 1.24.5
 require (
 	google.golang.org/grpc v1.73.0
 	google.golang.org/protobuf v1.36.6
-
 )
 
 require (
@@ -14,4 +13,7 @@ require (
 	golang.org/x/sys v0.34.0 // indirect
 	golang.org/x/text v0.26.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250324211829-b45e905df463 // indirect
+	shared v0.0.0
 )
+
+replace shared => ../shared
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"api/internal/config"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Authenticator resolve o bearer token de uma requisição para a identidade do chamador.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+}
+
+// chain tenta cada autenticador na ordem, aceitando o primeiro que reconhecer o token.
+type chain []Authenticator
+
+func (c chain) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	err := ErrInvalidToken
+	for _, authenticator := range c {
+		var identity *Identity
+		identity, err = authenticator.Authenticate(ctx, token)
+		if err == nil {
+			return identity, nil
+		}
+	}
+	return nil, err
+}
+
+// NewAuthenticator monta o autenticador da API REST conforme API_AUTH_MODE: chaves estáticas,
+// JWT de um provedor OIDC, ou ambos (chaves estáticas são verificadas primeiro).
+func NewAuthenticator(cfg config.APICentralConfig) (Authenticator, error) {
+	var authenticators chain
+	if cfg.AuthMode == config.AuthModeStatic || cfg.AuthMode == config.AuthModeBoth {
+		keyStore, err := NewKeyStore(cfg.APIKeys)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, keyStore)
+	}
+	if cfg.AuthMode == config.AuthModeJWT || cfg.AuthMode == config.AuthModeBoth {
+		validator, err := NewJWTValidator(cfg.JWT)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, validator)
+	}
+	if len(authenticators) == 0 {
+		return nil, fmt.Errorf("unsupported auth mode %q", cfg.AuthMode)
+	}
+	if len(authenticators) == 1 {
+		return authenticators[0], nil
+	}
+	return authenticators, nil
+}
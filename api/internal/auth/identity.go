@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+type contextKey struct{}
+
+// Prefixos de Identity.Name. Chaves de API e subjects de JWT ficam em espaços de nomes separados,
+// para que um "sub" emitido pelo provedor nunca se passe pelo nome de uma chave, e vice-versa.
+const (
+	APIKeyIdentityPrefix = "key:"
+	JWTIdentityPrefix    = "jwt:"
+)
+
+// Identity representa o chamador autenticado de uma requisição REST. Name é "key:<nome da chave>"
+// ou "jwt:<sub>"; Groups só é preenchido para identidades vindas de JWT.
+type Identity struct {
+	Name   string
+	Scopes []string
+	Groups []string
+}
+
+// HasScope informa se a identidade possui o escopo pedido. São aceitos o curinga global "*"
+// e curingas por serviço como "secrets:*".
+func (i *Identity) HasScope(scope string) bool {
+	if i == nil {
+		return false
+	}
+	service, _, _ := strings.Cut(scope, ":")
+	for _, granted := range i.Scopes {
+		if granted == "*" || granted == scope || granted == service+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// FromContext retorna a identidade associada à requisição, ou nil quando não autenticada.
+func FromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(contextKey{}).(*Identity)
+	return identity
+}
@@ -0,0 +1,222 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	jwksCacheTTL      = 10 * time.Minute
+	jwksMinRefreshGap = 30 * time.Second
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// JWKS mantém as chaves públicas do provedor de identidade, carregadas de um arquivo local
+// ou de uma URL. Chaves vindas de URL são recarregadas periodicamente e quando chega um kid desconhecido.
+type JWKS struct {
+	url        string
+	file       string
+	httpClient *http.Client
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func NewJWKSFromFile(path string) (*JWKS, error) {
+	j := &JWKS{file: path}
+	if err := j.refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func NewJWKSFromURL(url string) *JWKS {
+	return &JWKS{url: url, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Key retorna a chave pública identificada por kid. Quando o token não traz kid, a chave é
+// aceita apenas se o conjunto tiver uma única chave.
+func (j *JWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := j.lookup(kid); ok && !j.stale() {
+		return key, nil
+	}
+	if j.url != "" && j.canRefresh() {
+		if err := j.refresh(ctx); err != nil {
+			slog.Error("Falha ao atualizar JWKS", "url", j.url, "error", err)
+		}
+	}
+	if key, ok := j.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("no JWKS key found for kid %q", kid)
+}
+
+func (j *JWKS) lookup(kid string) (crypto.PublicKey, bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if kid == "" {
+		if len(j.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range j.keys {
+			return key, true
+		}
+	}
+	key, ok := j.keys[kid]
+	return key, ok
+}
+
+func (j *JWKS) stale() bool {
+	if j.url == "" {
+		return false
+	}
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return time.Since(j.fetchedAt) > jwksCacheTTL
+}
+
+func (j *JWKS) canRefresh() bool {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return time.Since(j.fetchedAt) > jwksMinRefreshGap
+}
+
+func (j *JWKS) refresh(ctx context.Context) error {
+	var raw []byte
+	var err error
+	if j.file != "" {
+		raw, err = os.ReadFile(j.file)
+	} else {
+		raw, err = j.fetch(ctx)
+	}
+
+	j.mu.Lock()
+	j.fetchedAt = time.Now()
+	j.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	keys, err := parseJWKS(raw)
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	j.keys = keys
+	j.mu.Unlock()
+	return nil
+}
+
+func (j *JWKS) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := j.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+func parseJWKS(raw []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS document: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %w", jwk.Kid, err)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS document has no usable signing keys")
+	}
+	return keys, nil
+}
+
+// publicKey converte a JWK em chave pública. Tipos de chave não suportados são ignorados (nil, nil).
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, nil
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !key.Curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("EC point is not on curve P-256")
+		}
+		return key, nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(raw) == 0 {
+		return nil, fmt.Errorf("invalid base64url integer")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"api/internal/config"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwtLeeway tolera pequenas diferenças de relógio entre a API e o provedor de identidade.
+const jwtLeeway = 60 * time.Second
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// JWTValidator valida tokens JWT assinados com RS256 ou ES256 e converte os claims "sub" e de grupos
+// em uma identidade com os escopos configurados.
+type JWTValidator struct {
+	keys          *JWKS
+	issuer        string
+	audience      string
+	groupsClaim   string
+	groupScopes   map[string][]string
+	subjectScopes map[string][]string
+}
+
+func NewJWTValidator(cfg config.JWTConfig) (*JWTValidator, error) {
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, errors.New("JWT issuer and audience are required")
+	}
+	var keys *JWKS
+	if cfg.JWKSFile != "" {
+		var err error
+		if keys, err = NewJWKSFromFile(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+	} else {
+		keys = NewJWKSFromURL(cfg.JWKSURL)
+	}
+
+	for name, scopes := range cfg.GroupScopes {
+		if err := validateScopes(scopes); err != nil {
+			return nil, fmt.Errorf("invalid scopes for group %q: %w", name, err)
+		}
+	}
+	for name, scopes := range cfg.SubjectScopes {
+		if err := validateScopes(scopes); err != nil {
+			return nil, fmt.Errorf("invalid scopes for subject %q: %w", name, err)
+		}
+	}
+
+	return &JWTValidator{
+		keys:          keys,
+		issuer:        cfg.Issuer,
+		audience:      cfg.Audience,
+		groupsClaim:   cfg.GroupsClaim,
+		groupScopes:   cfg.GroupScopes,
+		subjectScopes: cfg.SubjectScopes,
+	}, nil
+}
+
+func (v *JWTValidator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	claims, err := v.verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: missing sub claim", ErrInvalidToken)
+	}
+
+	identity := &Identity{Name: JWTIdentityPrefix + subject}
+	seen := make(map[string]bool)
+	grant := func(scopes []string) {
+		for _, scope := range scopes {
+			if !seen[scope] {
+				seen[scope] = true
+				identity.Scopes = append(identity.Scopes, scope)
+			}
+		}
+	}
+	grant(v.subjectScopes[subject])
+	identity.Groups = stringList(claims[v.groupsClaim])
+	for _, group := range identity.Groups {
+		grant(v.groupScopes[group])
+	}
+	return identity, nil
+}
+
+// verify confere assinatura, algoritmo e os claims de tempo, emissor e audiência, retornando o payload decodificado.
+// Emissor e audiência são sempre exigidos.
+func (v *JWTValidator) verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed JWT", ErrInvalidToken)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: invalid JWT header", ErrInvalidToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid JWT signature encoding", ErrInvalidToken)
+	}
+
+	key, err := v.keys.Key(ctx, header.Kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := verifySignature(header.Alg, key, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: invalid JWT payload", ErrInvalidToken)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+func (v *JWTValidator) validateClaims(claims map[string]interface{}) error {
+	now := time.Now()
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return errors.New("missing exp claim")
+	}
+	if now.After(exp.Add(jwtLeeway)) {
+		return errors.New("token expired")
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(jwtLeeway).Before(nbf) {
+		return errors.New("token not yet valid")
+	}
+	if iss, _ := claims["iss"].(string); iss != v.issuer {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	for _, aud := range stringList(claims["aud"]) {
+		if aud == v.audience {
+			return nil
+		}
+	}
+	return errors.New("token audience does not match")
+}
+
+// verifySignature aceita apenas RS256 e ES256, e exige que o tipo da chave corresponda ao algoritmo
+// do header para impedir a troca de algoritmo pelo emissor do token.
+func verifySignature(alg string, key crypto.PublicKey, digest, signature []byte) error {
+	switch alg {
+	case "RS256":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("key type does not match RS256")
+		}
+		if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest, signature); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "ES256":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("key type does not match ES256")
+		}
+		if len(signature) != 64 {
+			return errors.New("invalid ES256 signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+}
+
+func decodeSegment(segment string, target interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, target)
+}
+
+func numericDate(value interface{}) (time.Time, bool) {
+	seconds, ok := value.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(seconds), 0), true
+}
+
+// stringList aceita claims que podem vir como string única ou como lista (ex.: "aud" e "groups").
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"api/internal/config"
+)
+
+const (
+	testIssuer   = "https://idp.test"
+	testAudience = "intranet-api"
+)
+
+// testJWKS serve um conjunto de chaves que pode ser trocado durante o teste e conta as buscas.
+type testJWKS struct {
+	mu      sync.Mutex
+	keys    []jsonWebKey
+	fetches atomic.Int32
+}
+
+func (s *testJWKS) set(keys ...jsonWebKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *testJWKS) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.fetches.Add(1)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": s.keys})
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) jsonWebKey {
+	return jsonWebKey{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) jsonWebKey {
+	return jsonWebKey{
+		Kty: "EC",
+		Kid: kid,
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func encodeSegment(t *testing.T, value interface{}) string {
+	t.Helper()
+	raw, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// signToken monta um JWT com o header e os claims informados, assinado conforme header["alg"].
+// key pode ser *rsa.PrivateKey, *ecdsa.PrivateKey ou []byte (HMAC); com "none" a assinatura fica vazia.
+func signToken(t *testing.T, header, claims map[string]interface{}, key interface{}) string {
+	t.Helper()
+	signingInput := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:]); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"sub":    "alice",
+		"iss":    testIssuer,
+		"aud":    []string{"other-client", testAudience},
+		"exp":    now.Add(time.Hour).Unix(),
+		"nbf":    now.Add(-time.Minute).Unix(),
+		"groups": []string{"infra"},
+	}
+}
+
+func withClaims(changes map[string]interface{}) map[string]interface{} {
+	claims := validClaims()
+	for name, value := range changes {
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+	}
+	return claims
+}
+
+func newTestValidator(t *testing.T, jwks *testJWKS) *JWTValidator {
+	t.Helper()
+	server := httptest.NewServer(jwks)
+	t.Cleanup(server.Close)
+	v, err := NewJWTValidator(config.JWTConfig{
+		JWKSURL:     server.URL,
+		Issuer:      testIssuer,
+		Audience:    testAudience,
+		GroupsClaim: "groups",
+		GroupScopes: map[string][]string{"infra": {"secrets:read"}},
+	})
+	if err != nil {
+		t.Fatalf("NewJWTValidator: %v", err)
+	}
+	return v
+}
+
+func TestJWTValidatorAuthenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := &testJWKS{}
+	jwks.set(rsaJWK("rsa-1", rsaKey), ecJWK("ec-1", ecKey))
+	v := newTestValidator(t, jwks)
+
+	rs256 := map[string]interface{}{"alg": "RS256", "kid": "rsa-1", "typ": "JWT"}
+	now := time.Now()
+	// A chave pública RSA serializada, usada como segredo HMAC no ataque de troca de algoritmo.
+	publicKeyAsSecret, _ := json.Marshal(rsaJWK("rsa-1", rsaKey))
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "valid RS256", token: signToken(t, rs256, validClaims(), rsaKey)},
+		{name: "valid ES256", token: signToken(t, map[string]interface{}{"alg": "ES256", "kid": "ec-1"}, validClaims(), ecKey)},
+		{name: "single audience string", token: signToken(t, rs256, withClaims(map[string]interface{}{"aud": testAudience}), rsaKey)},
+		{name: "bad signature", token: signToken(t, rs256, validClaims(), otherRSAKey), wantErr: true},
+		{name: "tampered payload", token: func() string {
+			signed := strings.Split(signToken(t, rs256, validClaims(), rsaKey), ".")
+			signed[1] = encodeSegment(t, withClaims(map[string]interface{}{"sub": "mallory"}))
+			return strings.Join(signed, ".")
+		}(), wantErr: true},
+		{name: "alg none", token: signToken(t, map[string]interface{}{"alg": "none", "kid": "rsa-1"}, validClaims(), nil), wantErr: true},
+		{name: "HMAC alg swap", token: signToken(t, map[string]interface{}{"alg": "HS256", "kid": "rsa-1"}, validClaims(), publicKeyAsSecret), wantErr: true},
+		{name: "ES256 header with RSA key", token: signToken(t, map[string]interface{}{"alg": "ES256", "kid": "rsa-1"}, validClaims(), ecKey), wantErr: true},
+		{name: "expired within leeway", token: signToken(t, rs256, withClaims(map[string]interface{}{"exp": now.Add(-30 * time.Second).Unix()}), rsaKey)},
+		{name: "expired beyond leeway", token: signToken(t, rs256, withClaims(map[string]interface{}{"exp": now.Add(-2 * time.Minute).Unix()}), rsaKey), wantErr: true},
+		{name: "missing exp", token: signToken(t, rs256, withClaims(map[string]interface{}{"exp": nil}), rsaKey), wantErr: true},
+		{name: "nbf within leeway", token: signToken(t, rs256, withClaims(map[string]interface{}{"nbf": now.Add(30 * time.Second).Unix()}), rsaKey)},
+		{name: "nbf beyond leeway", token: signToken(t, rs256, withClaims(map[string]interface{}{"nbf": now.Add(2 * time.Minute).Unix()}), rsaKey), wantErr: true},
+		{name: "audience mismatch", token: signToken(t, rs256, withClaims(map[string]interface{}{"aud": "other-client"}), rsaKey), wantErr: true},
+		{name: "missing audience", token: signToken(t, rs256, withClaims(map[string]interface{}{"aud": nil}), rsaKey), wantErr: true},
+		{name: "issuer mismatch", token: signToken(t, rs256, withClaims(map[string]interface{}{"iss": "https://evil.test"}), rsaKey), wantErr: true},
+		{name: "missing issuer", token: signToken(t, rs256, withClaims(map[string]interface{}{"iss": nil}), rsaKey), wantErr: true},
+		{name: "missing sub", token: signToken(t, rs256, withClaims(map[string]interface{}{"sub": nil}), rsaKey), wantErr: true},
+		{name: "malformed", token: "not.a-jwt", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := v.Authenticate(context.Background(), tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("Authenticate error = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			if identity.Name != "jwt:alice" || len(identity.Scopes) != 1 || identity.Scopes[0] != "secrets:read" {
+				t.Errorf("identity = %+v", identity)
+			}
+		})
+	}
+}
+
+func TestJWKSRefreshOnUnknownKid(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := &testJWKS{}
+	jwks.set(rsaJWK("old", oldKey))
+	v := newTestValidator(t, jwks)
+	ctx := context.Background()
+
+	if _, err := v.Authenticate(ctx, signToken(t, map[string]interface{}{"alg": "RS256", "kid": "old"}, validClaims(), oldKey)); err != nil {
+		t.Fatalf("Authenticate with old key: %v", err)
+	}
+	if got := jwks.fetches.Load(); got != 1 {
+		t.Fatalf("fetches = %d, want 1", got)
+	}
+
+	// O provedor rotaciona as chaves; um kid desconhecido logo após a busca anterior não deve
+	// disparar outra busca antes do intervalo mínimo.
+	jwks.set(rsaJWK("new", newKey))
+	newToken := signToken(t, map[string]interface{}{"alg": "RS256", "kid": "new"}, validClaims(), newKey)
+	if _, err := v.Authenticate(ctx, newToken); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Authenticate before refresh gap = %v, want ErrInvalidToken", err)
+	}
+	if got := jwks.fetches.Load(); got != 1 {
+		t.Fatalf("fetches = %d, want 1 (refresh gap not respected)", got)
+	}
+
+	v.keys.mu.Lock()
+	v.keys.fetchedAt = time.Now().Add(-2 * jwksMinRefreshGap)
+	v.keys.mu.Unlock()
+	if _, err := v.Authenticate(ctx, newToken); err != nil {
+		t.Fatalf("Authenticate after rotation: %v", err)
+	}
+	if got := jwks.fetches.Load(); got != 2 {
+		t.Fatalf("fetches = %d, want 2", got)
+	}
+
+	// Chaves conhecidas e ainda válidas no cache não geram novas buscas.
+	if _, err := v.Authenticate(ctx, newToken); err != nil {
+		t.Fatalf("Authenticate with cached key: %v", err)
+	}
+	if got := jwks.fetches.Load(); got != 2 {
+		t.Fatalf("fetches = %d, want 2", got)
+	}
+}
+
+func TestNewJWTValidatorRequiresIssuerAndAudience(t *testing.T) {
+	tests := []config.JWTConfig{
+		{JWKSURL: "https://idp.test/jwks", Audience: testAudience},
+		{JWKSURL: "https://idp.test/jwks", Issuer: testIssuer},
+	}
+	for _, cfg := range tests {
+		if _, err := NewJWTValidator(cfg); err == nil {
+			t.Errorf("NewJWTValidator(%+v) succeeded, want error", cfg)
+		}
+	}
+}
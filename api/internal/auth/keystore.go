@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"api/internal/config"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+type apiKey struct {
+	token    []byte
+	identity *Identity
+}
+
+// KeyStore guarda as chaves de API configuradas e resolve um token para a identidade correspondente.
+type KeyStore struct {
+	keys []apiKey
+}
+
+func NewKeyStore(keys []config.APIKeyConfig) (*KeyStore, error) {
+	store := &KeyStore{}
+	names := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if key.Name == "" || key.Token == "" {
+			return nil, fmt.Errorf("API key must have a name and a token")
+		}
+		if names[key.Name] {
+			return nil, fmt.Errorf("duplicate API key name %q", key.Name)
+		}
+		names[key.Name] = true
+		if err := validateScopes(key.Scopes); err != nil {
+			return nil, fmt.Errorf("invalid scopes for API key %q: %w", key.Name, err)
+		}
+		store.keys = append(store.keys, apiKey{
+			token:    []byte(key.Token),
+			identity: &Identity{Name: APIKeyIdentityPrefix + key.Name, Scopes: key.Scopes},
+		})
+	}
+	return store, nil
+}
+
+// Authenticate procura a chave correspondente ao token. Todas as chaves são comparadas em tempo
+// constante para não revelar, pelo tempo de resposta, qual delas se aproxima do token recebido.
+func (ks *KeyStore) Authenticate(_ context.Context, token string) (*Identity, error) {
+	var match *Identity
+	for _, key := range ks.keys {
+		if subtle.ConstantTimeCompare([]byte(token), key.token) == 1 && match == nil {
+			match = key.identity
+		}
+	}
+	if match == nil {
+		return nil, ErrInvalidToken
+	}
+	return match, nil
+}
+
+func validateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if scope == "" || strings.ContainsAny(scope, " \t") {
+			return fmt.Errorf("invalid scope %q", scope)
+		}
+	}
+	return nil
+}
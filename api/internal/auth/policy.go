@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"api/internal/config"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Capacidades avaliadas pelas políticas de segredos.
+const (
+	CapabilityRead    = "read"
+	CapabilityWrite   = "write"
+	CapabilityList    = "list"
+	CapabilityDelete  = "delete"
+	CapabilityDestroy = "destroy"
+	CapabilityDeny    = "deny"
+)
+
+type secretRule struct {
+	segments     []string
+	prefix       bool
+	capabilities []string
+}
+
+type secretPolicy struct {
+	name       string
+	identities []string
+	groups     []string
+	rules      []secretRule
+}
+
+// SecretPolicies avalia o acesso de uma identidade a um caminho do Vault. O acesso é negado por
+// padrão: é preciso que alguma regra aplicável conceda a capacidade, e qualquer regra "deny" que
+// case com o caminho prevalece sobre as demais.
+type SecretPolicies struct {
+	policies []secretPolicy
+}
+
+func NewSecretPolicies(policies []config.SecretPolicyConfig) (*SecretPolicies, error) {
+	sp := &SecretPolicies{}
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return nil, fmt.Errorf("secret policy must have a name")
+		}
+		if len(policy.Identities) == 0 && len(policy.Groups) == 0 {
+			return nil, fmt.Errorf("secret policy %q must list identities or groups", policy.Name)
+		}
+		for _, identity := range policy.Identities {
+			if identity != "*" && !strings.HasPrefix(identity, APIKeyIdentityPrefix) && !strings.HasPrefix(identity, JWTIdentityPrefix) {
+				return nil, fmt.Errorf("secret policy %q: identity %q must be \"*\" or start with %q or %q", policy.Name, identity, APIKeyIdentityPrefix, JWTIdentityPrefix)
+			}
+		}
+		compiled := secretPolicy{name: policy.Name, identities: policy.Identities, groups: policy.Groups}
+		for _, rule := range policy.Rules {
+			r, err := compileSecretRule(rule)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule in secret policy %q: %w", policy.Name, err)
+			}
+			compiled.rules = append(compiled.rules, r)
+		}
+		sp.policies = append(sp.policies, compiled)
+	}
+	return sp, nil
+}
+
+func compileSecretRule(rule config.SecretPolicyRule) (secretRule, error) {
+	pattern := strings.Trim(rule.Path, "/")
+	if pattern == "" {
+		return secretRule{}, fmt.Errorf("empty path")
+	}
+	r := secretRule{capabilities: rule.Capabilities}
+	if strings.HasSuffix(pattern, "*") {
+		r.prefix = true
+		pattern = strings.TrimSuffix(pattern, "*")
+	}
+	if strings.Contains(pattern, "*") {
+		return secretRule{}, fmt.Errorf("path %q: '*' is only allowed at the end", rule.Path)
+	}
+	r.segments = strings.Split(pattern, "/")
+
+	if len(rule.Capabilities) == 0 {
+		return secretRule{}, fmt.Errorf("path %q has no capabilities", rule.Path)
+	}
+	for _, capability := range rule.Capabilities {
+		switch capability {
+		case CapabilityRead, CapabilityWrite, CapabilityList, CapabilityDelete, CapabilityDestroy, CapabilityDeny:
+		default:
+			return secretRule{}, fmt.Errorf("path %q: unknown capability %q", rule.Path, capability)
+		}
+	}
+	return r, nil
+}
+
+// Allowed informa se a identidade possui a capacidade pedida sobre o caminho (já normalizado, sem "/" inicial).
+func (sp *SecretPolicies) Allowed(identity *Identity, secretPath, capability string) bool {
+	if identity == nil {
+		return false
+	}
+	allowed := false
+	for _, policy := range sp.policies {
+		if !policy.appliesTo(identity) {
+			continue
+		}
+		for _, rule := range policy.rules {
+			if !rule.matches(secretPath) {
+				continue
+			}
+			if slices.Contains(rule.capabilities, CapabilityDeny) {
+				return false
+			}
+			if slices.Contains(rule.capabilities, capability) {
+				allowed = true
+			}
+		}
+	}
+	return allowed
+}
+
+func (p secretPolicy) appliesTo(identity *Identity) bool {
+	if slices.Contains(p.identities, "*") || slices.Contains(p.identities, identity.Name) {
+		return true
+	}
+	for _, group := range identity.Groups {
+		if slices.Contains(p.groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches compara o caminho segmento a segmento. "+" casa um segmento qualquer; com "*" final, o último
+// segmento do padrão é tratado como prefixo e qualquer sufixo é aceito.
+func (r secretRule) matches(secretPath string) bool {
+	segments := strings.Split(secretPath, "/")
+	if len(segments) < len(r.segments) || (!r.prefix && len(segments) != len(r.segments)) {
+		return false
+	}
+	last := len(r.segments) - 1
+	for i, pattern := range r.segments {
+		if pattern == "+" {
+			continue
+		}
+		if i == last && r.prefix {
+			return strings.HasPrefix(segments[i], pattern)
+		}
+		if segments[i] != pattern {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"api/internal/config"
+)
+
+// Um JWT cujo "sub" é igual ao nome de uma chave de API não pode herdar as políticas da chave, nem o contrário.
+func TestSecretPoliciesSeparateAPIKeysFromJWTSubjects(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := &testJWKS{}
+	jwks.set(rsaJWK("rsa-1", rsaKey))
+	validator := newTestValidator(t, jwks)
+	keyStore, err := NewKeyStore([]config.APIKeyConfig{{Name: "ci", Token: "ci-token", Scopes: []string{"secrets:read"}}})
+	if err != nil {
+		t.Fatalf("NewKeyStore: %v", err)
+	}
+	policies, err := NewSecretPolicies([]config.SecretPolicyConfig{{
+		Name:       "ci-key",
+		Identities: []string{"key:ci"},
+		Rules:      []config.SecretPolicyRule{{Path: "kv/ci/*", Capabilities: []string{"read"}}},
+	}, {
+		Name:       "ci-subject",
+		Identities: []string{"jwt:ci"},
+		Rules:      []config.SecretPolicyRule{{Path: "kv/idp/*", Capabilities: []string{"read"}}},
+	}})
+	if err != nil {
+		t.Fatalf("NewSecretPolicies: %v", err)
+	}
+
+	keyIdentity, err := keyStore.Authenticate(context.Background(), "ci-token")
+	if err != nil {
+		t.Fatalf("KeyStore.Authenticate: %v", err)
+	}
+	token := signToken(t, map[string]interface{}{"alg": "RS256", "kid": "rsa-1"}, withClaims(map[string]interface{}{"sub": "ci"}), rsaKey)
+	jwtIdentity, err := validator.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("JWTValidator.Authenticate: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		identity *Identity
+		path     string
+		want     bool
+	}{
+		{name: "key reads its path", identity: keyIdentity, path: "kv/ci/app", want: true},
+		{name: "key does not get subject policy", identity: keyIdentity, path: "kv/idp/app"},
+		{name: "subject reads its path", identity: jwtIdentity, path: "kv/idp/app", want: true},
+		{name: "subject does not get key policy", identity: jwtIdentity, path: "kv/ci/app"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policies.Allowed(tt.identity, tt.path, CapabilityRead); got != tt.want {
+				t.Errorf("Allowed(%s, %s) = %v, want %v", tt.identity.Name, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSecretPoliciesRejectsUnprefixedIdentities(t *testing.T) {
+	_, err := NewSecretPolicies([]config.SecretPolicyConfig{{
+		Name:       "legacy",
+		Identities: []string{"ci"},
+		Rules:      []config.SecretPolicyRule{{Path: "kv/*", Capabilities: []string{"read"}}},
+	}})
+	if err == nil {
+		t.Fatal("NewSecretPolicies accepted an identity without key: or jwt: prefix")
+	}
+}
@@ -1,35 +1,110 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"strconv"
 )
 
 type VaultGatewayConfig struct {
-	Enabled   bool
-	Address   string
-	RoleID    string
-	SecretID  string
-	AuthToken string
+	Enabled       bool
+	Address       string
+	RoleID        string
+	SecretID      string
+	AuthToken     string
+	AuthTokenFile string
+	TLSServerName string
 }
 
 type ZabbixGatewayConfig struct {
-	Enabled   bool
-	APIURL    string
-	APIToken  string
-	AuthToken string
+	Enabled       bool
+	APIURL        string
+	APIToken      string
+	AuthToken     string
+	AuthTokenFile string
+	TLSServerName string
+}
+
+type NetboxGatewayConfig struct {
+	Enabled       bool
+	Address       string
+	AuthToken     string
+	AuthTokenFile string
+	TLSServerName string
+}
+
+// LegacyAPIKeyName é o nome reservado da chave criada a partir de API_REST_AUTH_TOKEN.
+const LegacyAPIKeyName = "legacy-rest-token"
+
+// APIKeyConfig descreve uma chave de API nomeada e os escopos que ela concede (ex.: "secrets:read").
+type APIKeyConfig struct {
+	Name   string   `json:"name"`
+	Token  string   `json:"token"`
+	Scopes []string `json:"scopes"`
+}
+
+// Modos de autenticação aceitos em API_AUTH_MODE.
+const (
+	AuthModeStatic = "static"
+	AuthModeJWT    = "jwt"
+	AuthModeBoth   = "both"
+)
+
+// JWTConfig configura a validação de tokens emitidos por um provedor OIDC. GroupScopes e SubjectScopes
+// mapeiam, respectivamente, os grupos do token e o claim "sub" para escopos da API.
+type JWTConfig struct {
+	JWKSURL       string
+	JWKSFile      string
+	Issuer        string
+	Audience      string
+	GroupsClaim   string
+	GroupScopes   map[string][]string
+	SubjectScopes map[string][]string
+}
+
+// SecretPolicyRule concede capacidades ("read", "write", "list", "delete", "destroy" ou "deny") sobre os caminhos
+// do Vault que casam com Path. Um "*" final casa qualquer sufixo e "+" casa exatamente um segmento.
+type SecretPolicyRule struct {
+	Path         string   `json:"path"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// SecretPolicyConfig associa um conjunto de regras às identidades ("key:<nome da chave de API>" ou
+// "jwt:<sub>") e aos grupos do JWT listados. A identidade "*" aplica a política a qualquer chamador autenticado.
+type SecretPolicyConfig struct {
+	Name       string             `json:"name"`
+	Identities []string           `json:"identities"`
+	Groups     []string           `json:"groups"`
+	Rules      []SecretPolicyRule `json:"rules"`
 }
 
 type APICentralConfig struct {
-	RESTAuthToken string
+	AuthMode       string
+	RESTAuthToken  string
+	APIKeys        []APIKeyConfig
+	JWT            JWTConfig
+	SecretPolicies []SecretPolicyConfig
+}
+
+// GatewayTLSConfig habilita mTLS nas conexões com os gateways. ServerName é opcional e substitui
+// o nome usado na verificação do certificado dos gateways que não definem <GATEWAY>_TLS_SERVER_NAME.
+type GatewayTLSConfig struct {
+	Enabled    bool
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
 }
 
 type Config struct {
 	VaultGateway             VaultGatewayConfig
 	ZabbixGateway            ZabbixGatewayConfig
+	NetboxGateway            NetboxGatewayConfig
 	APICentral               APICentralConfig
 	GatewayInternalAuthToken string
+	GatewayTLS               GatewayTLSConfig
 }
 
 func LoadConfig() (*Config, error) {
@@ -37,10 +112,52 @@ func LoadConfig() (*Config, error) {
 
 	cfg.APICentral.RESTAuthToken = os.Getenv("API_REST_AUTH_TOKEN")
 	cfg.GatewayInternalAuthToken = os.Getenv("INTERNAL_API_AUTH_TOKEN")
-	if cfg.GatewayInternalAuthToken == "" {
-		return nil, fmt.Errorf("INTERNAL_API_AUTH_TOKEN is required")
-	} else if cfg.APICentral.RESTAuthToken == "" {
-		return nil, fmt.Errorf("API_REST_AUTH_TOKEN is required")
+
+	apiKeys, err := loadAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	cfg.APICentral.APIKeys = apiKeys
+	if cfg.APICentral.RESTAuthToken != "" {
+		// O token legado continua aceito como uma chave com acesso total, sob um nome reservado.
+		cfg.APICentral.APIKeys = append(cfg.APICentral.APIKeys, APIKeyConfig{
+			Name:   LegacyAPIKeyName,
+			Token:  cfg.APICentral.RESTAuthToken,
+			Scopes: []string{"*"},
+		})
+	}
+
+	cfg.APICentral.AuthMode = os.Getenv("API_AUTH_MODE")
+	if cfg.APICentral.AuthMode == "" {
+		cfg.APICentral.AuthMode = AuthModeStatic
+	}
+	switch cfg.APICentral.AuthMode {
+	case AuthModeStatic, AuthModeJWT, AuthModeBoth:
+	default:
+		return nil, fmt.Errorf("invalid API_AUTH_MODE %q: must be static, jwt or both", cfg.APICentral.AuthMode)
+	}
+	if cfg.APICentral.AuthMode != AuthModeJWT && len(cfg.APICentral.APIKeys) == 0 {
+		return nil, fmt.Errorf("API_REST_AUTH_TOKEN or API_KEYS_FILE/API_KEYS is required")
+	}
+	if cfg.APICentral.AuthMode != AuthModeStatic {
+		jwtConfig, err := loadJWTConfig()
+		if err != nil {
+			return nil, err
+		}
+		cfg.APICentral.JWT = jwtConfig
+	}
+
+	gatewayTLSEnabled, _ := strconv.ParseBool(os.Getenv("GATEWAY_TLS_ENABLED"))
+	cfg.GatewayTLS.Enabled = gatewayTLSEnabled
+	if cfg.GatewayTLS.Enabled {
+		cfg.GatewayTLS.CAFile = os.Getenv("GATEWAY_TLS_CA_FILE")
+		cfg.GatewayTLS.CertFile = os.Getenv("GATEWAY_TLS_CERT_FILE")
+		cfg.GatewayTLS.KeyFile = os.Getenv("GATEWAY_TLS_KEY_FILE")
+		cfg.GatewayTLS.ServerName = os.Getenv("GATEWAY_TLS_SERVER_NAME")
+
+		if cfg.GatewayTLS.CAFile == "" || cfg.GatewayTLS.CertFile == "" || cfg.GatewayTLS.KeyFile == "" {
+			return nil, fmt.Errorf("GATEWAY_TLS_CA_FILE, GATEWAY_TLS_CERT_FILE and GATEWAY_TLS_KEY_FILE are required when GATEWAY_TLS_ENABLED is true")
+		}
 	}
 
 	vaultEnabled, _ := strconv.ParseBool(os.Getenv("VAULT_GATEWAY_ENABLED"))
@@ -51,6 +168,25 @@ func LoadConfig() (*Config, error) {
 		if cfg.VaultGateway.Address == "" {
 			return nil, fmt.Errorf("VAULT_GATEWAY_ADDRESS is required when VAULT_GATEWAY_ENABLED is true")
 		}
+		cfg.VaultGateway.AuthToken, cfg.VaultGateway.AuthTokenFile, err = loadGatewayToken("VAULT_GATEWAY", cfg.GatewayInternalAuthToken)
+		if err != nil {
+			return nil, err
+		}
+		cfg.VaultGateway.TLSServerName = gatewayTLSServerName("VAULT_GATEWAY", cfg.GatewayTLS.ServerName)
+
+		policies, err := loadSecretPolicies()
+		if err != nil {
+			return nil, err
+		}
+		// Sem políticas a API sobe negando todo acesso a segredos. SECRETS_POLICY_REQUIRED=true torna
+		// a ausência de políticas um erro de inicialização.
+		if len(policies) == 0 {
+			if required, _ := strconv.ParseBool(os.Getenv("SECRETS_POLICY_REQUIRED")); required {
+				return nil, fmt.Errorf("SECRETS_POLICY_FILE or SECRETS_POLICIES is required when SECRETS_POLICY_REQUIRED is true")
+			}
+			slog.Warn("VAULT_GATEWAY_ENABLED sem SECRETS_POLICY_FILE ou SECRETS_POLICIES: todo acesso a segredos será negado")
+		}
+		cfg.APICentral.SecretPolicies = policies
 	}
 
 	zabbixEnabled, _ := strconv.ParseBool(os.Getenv("ZABBIX_GATEWAY_ENABLED"))
@@ -61,7 +197,146 @@ func LoadConfig() (*Config, error) {
 		if cfg.ZabbixGateway.APIURL == "" {
 			return nil, fmt.Errorf("ZABBIX_GATEWAY_API_URL is required when ZABBIX_ENABLED is true")
 		}
+		cfg.ZabbixGateway.AuthToken, cfg.ZabbixGateway.AuthTokenFile, err = loadGatewayToken("ZABBIX_GATEWAY", cfg.GatewayInternalAuthToken)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ZabbixGateway.TLSServerName = gatewayTLSServerName("ZABBIX_GATEWAY", cfg.GatewayTLS.ServerName)
+	}
+
+	netboxEnabled, _ := strconv.ParseBool(os.Getenv("NETBOX_GATEWAY_ENABLED"))
+	cfg.NetboxGateway.Enabled = netboxEnabled
+	if cfg.NetboxGateway.Enabled {
+		cfg.NetboxGateway.Address = os.Getenv("NETBOX_GATEWAY_ADDRESS")
+
+		if cfg.NetboxGateway.Address == "" {
+			return nil, fmt.Errorf("NETBOX_GATEWAY_ADDRESS is required when NETBOX_GATEWAY_ENABLED is true")
+		}
+		cfg.NetboxGateway.AuthToken, cfg.NetboxGateway.AuthTokenFile, err = loadGatewayToken("NETBOX_GATEWAY", cfg.GatewayInternalAuthToken)
+		if err != nil {
+			return nil, err
+		}
+		cfg.NetboxGateway.TLSServerName = gatewayTLSServerName("NETBOX_GATEWAY", cfg.GatewayTLS.ServerName)
 	}
 
 	return cfg, nil
 }
+
+// loadGatewayToken lê o token interno de um gateway de <prefix>_AUTH_TOKEN_FILE ou <prefix>_AUTH_TOKEN.
+// Sem nenhum dos dois, vale o token compartilhado INTERNAL_API_AUTH_TOKEN.
+func loadGatewayToken(prefix, sharedToken string) (string, string, error) {
+	if path := os.Getenv(prefix + "_AUTH_TOKEN_FILE"); path != "" {
+		return "", path, nil
+	}
+	if token := os.Getenv(prefix + "_AUTH_TOKEN"); token != "" {
+		return token, "", nil
+	}
+	if sharedToken == "" {
+		return "", "", fmt.Errorf("%s_AUTH_TOKEN, %s_AUTH_TOKEN_FILE or INTERNAL_API_AUTH_TOKEN is required", prefix, prefix)
+	}
+	return sharedToken, "", nil
+}
+
+// gatewayTLSServerName lê o nome usado na verificação do certificado de um gateway de
+// <prefix>_TLS_SERVER_NAME. Sem ele, vale o nome compartilhado GATEWAY_TLS_SERVER_NAME.
+func gatewayTLSServerName(prefix, sharedName string) string {
+	if name := os.Getenv(prefix + "_TLS_SERVER_NAME"); name != "" {
+		return name
+	}
+	return sharedName
+}
+
+// loadAPIKeys lê as chaves de API do arquivo JSON indicado em API_KEYS_FILE ou, na ausência dele,
+// do JSON definido diretamente em API_KEYS. O formato é uma lista de objetos {name, token, scopes}.
+func loadAPIKeys() ([]APIKeyConfig, error) {
+	var raw []byte
+	if path := os.Getenv("API_KEYS_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read API_KEYS_FILE: %w", err)
+		}
+		raw = data
+	} else if value := os.Getenv("API_KEYS"); value != "" {
+		raw = []byte(value)
+	} else {
+		return nil, nil
+	}
+
+	var keys []APIKeyConfig
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		return nil, fmt.Errorf("invalid API keys definition: %w", err)
+	}
+	// Nomes identificam a chave nas políticas de segredos e nos logs, então não podem se repetir
+	// nem usar o nome reservado ao token legado.
+	names := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if key.Name == LegacyAPIKeyName {
+			return nil, fmt.Errorf("API key name %q is reserved", key.Name)
+		}
+		if names[key.Name] {
+			return nil, fmt.Errorf("duplicate API key name %q", key.Name)
+		}
+		names[key.Name] = true
+	}
+	return keys, nil
+}
+
+// loadJWTConfig lê a configuração OIDC/JWT. Os mapeamentos de escopos são objetos JSON no formato
+// {"<grupo ou sub>": ["secrets:read", ...]}.
+func loadJWTConfig() (JWTConfig, error) {
+	jwtConfig := JWTConfig{
+		JWKSURL:     os.Getenv("JWT_JWKS_URL"),
+		JWKSFile:    os.Getenv("JWT_JWKS_FILE"),
+		Issuer:      os.Getenv("JWT_ISSUER"),
+		Audience:    os.Getenv("JWT_AUDIENCE"),
+		GroupsClaim: os.Getenv("JWT_GROUPS_CLAIM"),
+	}
+	if jwtConfig.JWKSURL == "" && jwtConfig.JWKSFile == "" {
+		return JWTConfig{}, fmt.Errorf("JWT_JWKS_URL or JWT_JWKS_FILE is required when API_AUTH_MODE is jwt or both")
+	}
+	if jwtConfig.JWKSURL != "" && jwtConfig.JWKSFile != "" {
+		return JWTConfig{}, fmt.Errorf("JWT_JWKS_URL and JWT_JWKS_FILE are mutually exclusive")
+	}
+	// Sem emissor e audiência fixos, qualquer token que o provedor assine para outro cliente seria aceito.
+	if jwtConfig.Issuer == "" || jwtConfig.Audience == "" {
+		return JWTConfig{}, fmt.Errorf("JWT_ISSUER and JWT_AUDIENCE are required when API_AUTH_MODE is jwt or both")
+	}
+	if jwtConfig.GroupsClaim == "" {
+		jwtConfig.GroupsClaim = "groups"
+	}
+
+	if value := os.Getenv("JWT_GROUP_SCOPES"); value != "" {
+		if err := json.Unmarshal([]byte(value), &jwtConfig.GroupScopes); err != nil {
+			return JWTConfig{}, fmt.Errorf("invalid JWT_GROUP_SCOPES: %w", err)
+		}
+	}
+	if value := os.Getenv("JWT_SUBJECT_SCOPES"); value != "" {
+		if err := json.Unmarshal([]byte(value), &jwtConfig.SubjectScopes); err != nil {
+			return JWTConfig{}, fmt.Errorf("invalid JWT_SUBJECT_SCOPES: %w", err)
+		}
+	}
+	return jwtConfig, nil
+}
+
+// loadSecretPolicies lê as políticas de acesso a segredos do arquivo JSON indicado em SECRETS_POLICY_FILE
+// ou, na ausência dele, do JSON definido diretamente em SECRETS_POLICIES.
+func loadSecretPolicies() ([]SecretPolicyConfig, error) {
+	var raw []byte
+	if path := os.Getenv("SECRETS_POLICY_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SECRETS_POLICY_FILE: %w", err)
+		}
+		raw = data
+	} else if value := os.Getenv("SECRETS_POLICIES"); value != "" {
+		raw = []byte(value)
+	} else {
+		return nil, nil
+	}
+
+	var policies []SecretPolicyConfig
+	if err := json.Unmarshal(raw, &policies); err != nil {
+		return nil, fmt.Errorf("invalid secret policies definition: %w", err)
+	}
+	return policies, nil
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoadJWTConfigRequiresIssuerAndAudience(t *testing.T) {
+	tests := []struct {
+		name     string
+		issuer   string
+		audience string
+		wantErr  bool
+	}{
+		{name: "issuer and audience", issuer: "https://idp.test", audience: "intranet-api"},
+		{name: "missing issuer", audience: "intranet-api", wantErr: true},
+		{name: "missing audience", issuer: "https://idp.test", wantErr: true},
+		{name: "missing both", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_JWKS_URL", "https://idp.test/jwks")
+			t.Setenv("JWT_JWKS_FILE", "")
+			t.Setenv("JWT_ISSUER", tt.issuer)
+			t.Setenv("JWT_AUDIENCE", tt.audience)
+
+			cfg, err := loadJWTConfig()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("loadJWTConfig succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadJWTConfig: %v", err)
+			}
+			if cfg.Issuer != tt.issuer || cfg.Audience != tt.audience || cfg.GroupsClaim != "groups" {
+				t.Errorf("config = %+v", cfg)
+			}
+		})
+	}
+}
+
+func TestLoadConfigSecretPolicies(t *testing.T) {
+	tests := []struct {
+		name         string
+		policies     string
+		required     string
+		wantErr      bool
+		wantPolicies int
+	}{
+		{name: "no policies denies all", wantPolicies: 0},
+		{name: "no policies when required", required: "true", wantErr: true},
+		{name: "inline policies", policies: `[{"name":"ops","identities":["key:admin"],"rules":[{"path":"kv/*","capabilities":["read"]}]}]`, required: "true", wantPolicies: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("API_AUTH_MODE", "static")
+			t.Setenv("API_REST_AUTH_TOKEN", "admin-token")
+			t.Setenv("VAULT_GATEWAY_ENABLED", "true")
+			t.Setenv("VAULT_GATEWAY_ADDRESS", "vault-gateway:50051")
+			t.Setenv("VAULT_GATEWAY_AUTH_TOKEN", "gateway-token")
+			t.Setenv("SECRETS_POLICY_FILE", "")
+			t.Setenv("SECRETS_POLICIES", tt.policies)
+			t.Setenv("SECRETS_POLICY_REQUIRED", tt.required)
+
+			cfg, err := LoadConfig()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("LoadConfig succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			if len(cfg.APICentral.SecretPolicies) != tt.wantPolicies {
+				t.Errorf("policies = %v, want %d", cfg.APICentral.SecretPolicies, tt.wantPolicies)
+			}
+		})
+	}
+}
+
+func TestLoadConfigAPIKeyNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		keys      string
+		wantErr   bool
+		wantNames []string
+	}{
+		{name: "legacy token uses reserved name", keys: `[{"name":"default","token":"t1","scopes":["zabbix:read"]}]`, wantNames: []string{"default", LegacyAPIKeyName}},
+		{name: "duplicate names", keys: `[{"name":"ci","token":"t1"},{"name":"ci","token":"t2"}]`, wantErr: true},
+		{name: "reserved name", keys: `[{"name":"legacy-rest-token","token":"t1"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("API_AUTH_MODE", "static")
+			t.Setenv("API_REST_AUTH_TOKEN", "admin-token")
+			t.Setenv("API_KEYS_FILE", "")
+			t.Setenv("API_KEYS", tt.keys)
+			t.Setenv("VAULT_GATEWAY_ENABLED", "false")
+
+			cfg, err := LoadConfig()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("LoadConfig succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			var names []string
+			for _, key := range cfg.APICentral.APIKeys {
+				names = append(names, key.Name)
+			}
+			if !slices.Equal(names, tt.wantNames) {
+				t.Errorf("key names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestLoadConfigGatewayTLSServerName(t *testing.T) {
+	tests := []struct {
+		name       string
+		sharedName string
+		vaultName  string
+		netboxName string
+		wantVault  string
+		wantNetbox string
+		wantZabbix string
+	}{
+		{name: "shared name", sharedName: "gateways.internal", wantVault: "gateways.internal", wantNetbox: "gateways.internal", wantZabbix: "gateways.internal"},
+		{name: "per gateway override", sharedName: "gateways.internal", vaultName: "vault-gateway.internal", wantVault: "vault-gateway.internal", wantNetbox: "gateways.internal", wantZabbix: "gateways.internal"},
+		{name: "override without shared name", netboxName: "netbox-gateway.internal", wantNetbox: "netbox-gateway.internal"},
+		{name: "no names"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("API_AUTH_MODE", "static")
+			t.Setenv("API_REST_AUTH_TOKEN", "admin-token")
+			t.Setenv("INTERNAL_API_AUTH_TOKEN", "gateway-token")
+			t.Setenv("GATEWAY_TLS_ENABLED", "true")
+			t.Setenv("GATEWAY_TLS_CA_FILE", "ca.pem")
+			t.Setenv("GATEWAY_TLS_CERT_FILE", "api.pem")
+			t.Setenv("GATEWAY_TLS_KEY_FILE", "api-key.pem")
+			t.Setenv("GATEWAY_TLS_SERVER_NAME", tt.sharedName)
+			t.Setenv("VAULT_GATEWAY_ENABLED", "true")
+			t.Setenv("VAULT_GATEWAY_ADDRESS", "vault-gateway:50051")
+			t.Setenv("VAULT_GATEWAY_TLS_SERVER_NAME", tt.vaultName)
+			t.Setenv("NETBOX_GATEWAY_ENABLED", "true")
+			t.Setenv("NETBOX_GATEWAY_ADDRESS", "netbox-gateway:50051")
+			t.Setenv("NETBOX_GATEWAY_TLS_SERVER_NAME", tt.netboxName)
+			t.Setenv("ZABBIX_GATEWAY_ENABLED", "true")
+			t.Setenv("ZABBIX_GATEWAY_API_URL", "zabbix-gateway:50051")
+			t.Setenv("ZABBIX_GATEWAY_TLS_SERVER_NAME", "")
+
+			cfg, err := LoadConfig()
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			if cfg.VaultGateway.TLSServerName != tt.wantVault {
+				t.Errorf("vault server name = %q, want %q", cfg.VaultGateway.TLSServerName, tt.wantVault)
+			}
+			if cfg.NetboxGateway.TLSServerName != tt.wantNetbox {
+				t.Errorf("netbox server name = %q, want %q", cfg.NetboxGateway.TLSServerName, tt.wantNetbox)
+			}
+			if cfg.ZabbixGateway.TLSServerName != tt.wantZabbix {
+				t.Errorf("zabbix server name = %q, want %q", cfg.ZabbixGateway.TLSServerName, tt.wantZabbix)
+			}
+		})
+	}
+}
@@ -2,24 +2,51 @@ package gateways
 
 import (
 	"api/internal/config"
+	authinterceptor "api/internal/grpcclients/auth_interceptor"
+	netbox_client "api/internal/grpcclients/netbox"
 	"api/internal/grpcclients/vault"
 	zabbix_client "api/internal/grpcclients/zabbix"
 	vault_proto "api/proto/vault"
 	zabbix_proto "api/proto/zabbix"
+	"context"
 	"log/slog"
+	"shared/tlsconfig"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Manager struct {
 	VaultClient  vault_proto.SecretServiceClient
 	ZabbixClient zabbix_proto.MonitoringServiceClient
+	NetboxClient *netbox_client.Client
 }
 
-func NewManager(cfg *config.Config) (*Manager, error) {
+func NewManager(ctx context.Context, cfg *config.Config) (*Manager, error) {
 	manager := &Manager{}
-	var err error
+
+	// Com mTLS, cada gateway verifica o certificado com o próprio nome; o certificado e a CA são os mesmos.
+	creds := func(string) credentials.TransportCredentials { return insecure.NewCredentials() }
+	if cfg.GatewayTLS.Enabled {
+		tlsReloader, err := tlsconfig.NewReloader(cfg.GatewayTLS.CAFile, cfg.GatewayTLS.CertFile, cfg.GatewayTLS.KeyFile)
+		if err != nil {
+			slog.Error("Failed to load gateway TLS certificates", "error", err)
+			return nil, err
+		}
+		go tlsReloader.Watch(ctx, tlsconfig.ReloadInterval)
+		creds = func(serverName string) credentials.TransportCredentials {
+			return credentials.NewTLS(tlsReloader.ClientConfig(serverName))
+		}
+		slog.Info("mTLS enabled for gateway connections")
+	}
 
 	if cfg.VaultGateway.Enabled {
-		manager.VaultClient, err = vault.NewClient(cfg.VaultGateway.Address, cfg.GatewayInternalAuthToken)
+		vaultAuth, err := newAuthInterceptor(ctx, cfg.VaultGateway.AuthToken, cfg.VaultGateway.AuthTokenFile)
+		if err != nil {
+			slog.Error("Failed to load Vault gateway token", "error", err)
+			return nil, err
+		}
+		manager.VaultClient, err = vault.NewClient(cfg.VaultGateway.Address, vaultAuth, creds(cfg.VaultGateway.TLSServerName))
 		if err != nil {
 			slog.Error("Failed to create Vault client", "error", err)
 			return nil, err
@@ -27,13 +54,45 @@ func NewManager(cfg *config.Config) (*Manager, error) {
 		slog.Info("Vault client created successfully")
 	}
 	if cfg.ZabbixGateway.Enabled {
-		manager.ZabbixClient, err = zabbix_client.NewZabbixClient(cfg.ZabbixGateway.APIURL, cfg.GatewayInternalAuthToken)
+		zabbixAuth, err := newAuthInterceptor(ctx, cfg.ZabbixGateway.AuthToken, cfg.ZabbixGateway.AuthTokenFile)
+		if err != nil {
+			slog.Error("Failed to load Zabbix gateway token", "error", err)
+			return nil, err
+		}
+		manager.ZabbixClient, err = zabbix_client.NewZabbixClient(cfg.ZabbixGateway.APIURL, zabbixAuth, creds(cfg.ZabbixGateway.TLSServerName))
 		if err != nil {
 			slog.Error("Failed to create Zabbix client", "error", err)
 			return nil, err
 		}
 		slog.Info("Zabbix client initialized successfully")
 	}
+	if cfg.NetboxGateway.Enabled {
+		netboxAuth, err := newAuthInterceptor(ctx, cfg.NetboxGateway.AuthToken, cfg.NetboxGateway.AuthTokenFile)
+		if err != nil {
+			slog.Error("Failed to load NetBox gateway token", "error", err)
+			return nil, err
+		}
+		manager.NetboxClient, err = netbox_client.NewNetboxClient(cfg.NetboxGateway.Address, netboxAuth, creds(cfg.NetboxGateway.TLSServerName))
+		if err != nil {
+			slog.Error("Failed to create NetBox client", "error", err)
+			return nil, err
+		}
+		slog.Info("NetBox client initialized successfully")
+	}
 
 	return manager, nil
 }
+
+// newAuthInterceptor cria o interceptor com o token do gateway; quando o token vem de arquivo,
+// ele é acompanhado até o fim de ctx para que uma rotação seja aplicada sem reiniciar a API.
+func newAuthInterceptor(ctx context.Context, token, tokenFile string) (*authinterceptor.AuthInterceptor, error) {
+	if tokenFile == "" {
+		return authinterceptor.NewAuthInterceptor(token), nil
+	}
+	interceptor, err := authinterceptor.NewFileAuthInterceptor(tokenFile)
+	if err != nil {
+		return nil, err
+	}
+	go interceptor.Watch(ctx, authinterceptor.TokenReloadInterval)
+	return interceptor, nil
+}
@@ -1,21 +1,124 @@
 package authinterceptor
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-type AuthInterceptor struct{ AuthToken string }
+// TokenReloadInterval é o intervalo com que Watch verifica se o arquivo de token mudou.
+const TokenReloadInterval = 10 * time.Second
+
+// AuthInterceptor envia o token interno de um gateway em cada chamada. Quando criado a partir de
+// um arquivo, o token é relido sempre que o arquivo muda, permitindo a rotação sem reiniciar a API.
+type AuthInterceptor struct {
+	tokenFile string
+
+	mu      sync.RWMutex
+	token   string
+	modTime time.Time
+}
 
 func NewAuthInterceptor(authToken string) *AuthInterceptor {
-	return &AuthInterceptor{AuthToken: authToken}
+	return &AuthInterceptor{token: authToken}
+}
+
+// NewFileAuthInterceptor lê o token da primeira linha não vazia (e que não comece com "#") do arquivo.
+func NewFileAuthInterceptor(tokenFile string) (*AuthInterceptor, error) {
+	interceptor := &AuthInterceptor{tokenFile: tokenFile}
+	if err := interceptor.reload(); err != nil {
+		return nil, err
+	}
+	return interceptor, nil
 }
 
 func (interceptor *AuthInterceptor) Unary() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		ctxWithToken := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+interceptor.AuthToken)
+		ctxWithToken := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+interceptor.currentToken())
 		return invoker(ctxWithToken, method, req, reply, cc, opts...)
 	}
 }
+
+func (interceptor *AuthInterceptor) Stream() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctxWithToken := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+interceptor.currentToken())
+		return streamer(ctxWithToken, desc, cc, method, opts...)
+	}
+}
+
+// Watch relê o arquivo de token quando ele muda no disco, até o cancelamento de ctx.
+// Interceptors com token fixo retornam imediatamente.
+func (interceptor *AuthInterceptor) Watch(ctx context.Context, interval time.Duration) {
+	if interceptor.tokenFile == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(interceptor.tokenFile)
+			if err != nil {
+				continue
+			}
+			interceptor.mu.RLock()
+			unchanged := info.ModTime().Equal(interceptor.modTime)
+			interceptor.mu.RUnlock()
+			if unchanged {
+				continue
+			}
+			if err := interceptor.reload(); err != nil {
+				slog.Error("Falha ao recarregar token interno", "path", interceptor.tokenFile, "error", err)
+				continue
+			}
+			slog.Info("Token interno recarregado", "path", interceptor.tokenFile)
+		}
+	}
+}
+
+func (interceptor *AuthInterceptor) currentToken() string {
+	interceptor.mu.RLock()
+	defer interceptor.mu.RUnlock()
+	return interceptor.token
+}
+
+func (interceptor *AuthInterceptor) reload() error {
+	info, err := os.Stat(interceptor.tokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to stat token file: %w", err)
+	}
+	raw, err := os.ReadFile(interceptor.tokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := ""
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			token = line
+			break
+		}
+	}
+	if token == "" {
+		return fmt.Errorf("token file %s has no token", interceptor.tokenFile)
+	}
+
+	interceptor.mu.Lock()
+	interceptor.token = token
+	interceptor.modTime = info.ModTime()
+	interceptor.mu.Unlock()
+	return nil
+}
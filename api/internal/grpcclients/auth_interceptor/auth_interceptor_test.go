@@ -0,0 +1,169 @@
+package authinterceptor
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeGateway é um servidor gRPC em memória com o serviço de health padrão, que guarda o cabeçalho
+// authorization recebido em cada chamada, unária ou stream.
+type fakeGateway struct {
+	mu      sync.Mutex
+	headers [][]string
+}
+
+func (g *fakeGateway) record(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.headers = append(g.headers, md.Get("authorization"))
+}
+
+func (g *fakeGateway) last() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.headers) == 0 {
+		return nil
+	}
+	return g.headers[len(g.headers)-1]
+}
+
+// dialFakeGateway sobe o servidor falso e devolve um cliente de health que passa pelo interceptor.
+func dialFakeGateway(t *testing.T, interceptor *AuthInterceptor) (healthpb.HealthClient, *fakeGateway) {
+	t.Helper()
+	gateway := &fakeGateway{}
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			gateway.record(ctx)
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			gateway.record(stream.Context())
+			return handler(srv, stream)
+		}),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(interceptor.Unary()),
+		grpc.WithStreamInterceptor(interceptor.Stream()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return healthpb.NewHealthClient(conn), gateway
+}
+
+// sentHeaders faz uma chamada unária e abre um stream, e devolve o cabeçalho recebido em cada uma.
+func sentHeaders(t *testing.T, client healthpb.HealthClient, gateway *fakeGateway) (unary, stream []string) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	unary = gateway.last()
+
+	watch, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if _, err := watch.Recv(); err != nil {
+		t.Fatalf("Watch.Recv: %v", err)
+	}
+	return unary, gateway.last()
+}
+
+func writeTokenFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertHeader(t *testing.T, kind string, got []string, want string) {
+	t.Helper()
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("%s authorization = %q, want [%q]", kind, got, want)
+	}
+}
+
+func TestStaticTokenIsSent(t *testing.T) {
+	interceptor := NewAuthInterceptor("static-token")
+	interceptor.Watch(context.Background(), time.Millisecond) // sem arquivo, retorna na hora
+	client, gateway := dialFakeGateway(t, interceptor)
+
+	unary, stream := sentHeaders(t, client, gateway)
+	assertHeader(t, "unary", unary, "Bearer static-token")
+	assertHeader(t, "stream", stream, "Bearer static-token")
+}
+
+func TestTokenFileChangeIsSent(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	start := time.Now().Add(-time.Hour)
+	writeTokenFile(t, file, "# token interno\n\ntoken-1\nignored\n", start)
+	interceptor, err := NewFileAuthInterceptor(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go interceptor.Watch(ctx, 5*time.Millisecond)
+	client, gateway := dialFakeGateway(t, interceptor)
+
+	unary, stream := sentHeaders(t, client, gateway)
+	assertHeader(t, "unary", unary, "Bearer token-1")
+	assertHeader(t, "stream", stream, "Bearer token-1")
+
+	writeTokenFile(t, file, "token-2\n", start.Add(time.Minute))
+	deadline := time.Now().Add(2 * time.Second)
+	for interceptor.currentToken() != "token-2" {
+		if time.Now().After(deadline) {
+			t.Fatal("token file change not picked up")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	unary, stream = sentHeaders(t, client, gateway)
+	assertHeader(t, "unary", unary, "Bearer token-2")
+	assertHeader(t, "stream", stream, "Bearer token-2")
+
+	// Um arquivo sem token não derruba o token em uso.
+	writeTokenFile(t, file, "# esvaziado por engano\n", start.Add(2*time.Minute))
+	time.Sleep(50 * time.Millisecond)
+	unary, _ = sentHeaders(t, client, gateway)
+	assertHeader(t, "unary", unary, "Bearer token-2")
+}
+
+func TestNewFileAuthInterceptorErrors(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	writeTokenFile(t, empty, "\n# sem token\n", time.Now())
+
+	if _, err := NewFileAuthInterceptor(filepath.Join(dir, "missing")); err == nil {
+		t.Error("missing file: expected an error")
+	}
+	if _, err := NewFileAuthInterceptor(empty); err == nil {
+		t.Error("file without token: expected an error")
+	}
+}
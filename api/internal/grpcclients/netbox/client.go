@@ -0,0 +1,46 @@
+package netbox_client
+
+import (
+	authinterceptor "api/internal/grpcclients/auth_interceptor"
+	"api/proto/dcim_proto"
+	"api/proto/ipam"
+	"api/proto/organization"
+	"api/proto/virtualization"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Client agrupa os clientes dos serviços expostos pelo NetBox Gateway, que compartilham a mesma conexão.
+type Client struct {
+	Organization   organization.OrganizationServiceClient
+	Dcim           dcim_proto.DcimServiceClient
+	Ipam           ipam.IpamServiceClient
+	Virtualization virtualization.VirtualizationServiceClient
+}
+
+// NewNetboxClient cria uma conexão e retorna os clientes gRPC para o NetBox Gateway.
+func NewNetboxClient(gatewayAddress string, authInterceptor *authinterceptor.AuthInterceptor, creds credentials.TransportCredentials) (*Client, error) {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(authInterceptor.Unary()),
+		grpc.WithStreamInterceptor(authInterceptor.Stream()),
+	}
+
+	slog.Info("Connecting to NetBox service", "address", gatewayAddress)
+	conn, err := grpc.NewClient(gatewayAddress, opts...)
+	if err != nil {
+		slog.Error("Failed to connect to NetBox service", "error", err)
+		return nil, err
+	}
+
+	client := &Client{
+		Organization:   organization.NewOrganizationServiceClient(conn),
+		Dcim:           dcim_proto.NewDcimServiceClient(conn),
+		Ipam:           ipam.NewIpamServiceClient(conn),
+		Virtualization: virtualization.NewVirtualizationServiceClient(conn),
+	}
+	slog.Info("Successfully connected to NetBox service", "address", gatewayAddress)
+	return client, nil
+}
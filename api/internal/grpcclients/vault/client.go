@@ -6,14 +6,14 @@ import (
 	"log/slog"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
-func NewClient(gatewayAddress string, authToken string) (vault.SecretServiceClient, error) {
-	authInterceptor := authinterceptor.NewAuthInterceptor(authToken)
+func NewClient(gatewayAddress string, authInterceptor *authinterceptor.AuthInterceptor, creds credentials.TransportCredentials) (vault.SecretServiceClient, error) {
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithUnaryInterceptor(authInterceptor.Unary()),
+		grpc.WithStreamInterceptor(authInterceptor.Stream()),
 	}
 
 	slog.Info("Connecting to Vault service", "address", gatewayAddress)
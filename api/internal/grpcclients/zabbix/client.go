@@ -6,15 +6,15 @@ import (
 	"log/slog"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 )
 
 // NewZabbixClient cria uma conexão e retorna um cliente gRPC para o Zabbix Gateway.
-func NewZabbixClient(gatewayAddress string, authToken string) (monitoring.MonitoringServiceClient, error) {
-	authInterceptor := authinterceptor.NewAuthInterceptor(authToken)
+func NewZabbixClient(gatewayAddress string, authInterceptor *authinterceptor.AuthInterceptor, creds credentials.TransportCredentials) (monitoring.MonitoringServiceClient, error) {
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithUnaryInterceptor(authInterceptor.Unary()),
+		grpc.WithStreamInterceptor(authInterceptor.Stream()),
 	}
 
 	slog.Info("Connecting to Zabbix service", "address", gatewayAddress)
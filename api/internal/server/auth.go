@@ -0,0 +1,81 @@
+package server
+
+import (
+	"api/internal/auth"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// requireAuth exige o header "Authorization: Bearer <token>" com uma chave de API ou um JWT válido,
+// conforme o modo de autenticação configurado, e associa a identidade do chamador ao contexto da requisição.
+func (s *Server) requireAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			s.respondUnauthorized(w, r, "Token de autenticação ausente")
+			return
+		}
+		identity, err := s.authenticator.Authenticate(r.Context(), token)
+		if err != nil {
+			slog.Debug("Falha na autenticação", "error", err)
+			s.respondUnauthorized(w, r, "Token de autenticação inválido")
+			return
+		}
+		slog.Info("Requisição autenticada", "caller", identity.Name, "method", r.Method, "path", r.URL.Path, "request_id", chi_middleware.GetReqID(r.Context()))
+		next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), identity)))
+	})
+}
+
+// requireScope exige "<service>:read" para requisições de leitura (GET/HEAD) e "<service>:write" para as demais.
+func (s *Server) requireScope(service string) func(http.Handler) http.Handler {
+	return s.scopeGuard(func(r *http.Request) string {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			return service + ":read"
+		}
+		return service + ":write"
+	})
+}
+
+// requireReadScope exige "<service>:read" em qualquer método, para rotas POST que apenas leem,
+// como a renderização de templates de segredos.
+func (s *Server) requireReadScope(service string) func(http.Handler) http.Handler {
+	return s.scopeGuard(func(*http.Request) string { return service + ":read" })
+}
+
+func (s *Server) scopeGuard(scopeFor func(r *http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope := scopeFor(r)
+			identity := auth.FromContext(r.Context())
+			if !identity.HasScope(scope) {
+				caller := ""
+				if identity != nil {
+					caller = identity.Name
+				}
+				slog.Warn("Acesso negado por falta de escopo", "caller", caller, "scope", scope, "method", r.Method, "path", r.URL.Path)
+				s.respondWithError(w, http.StatusForbidden, "Permissão insuficiente: escopo '"+scope+"' necessário", nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bearerToken extrai o token do header Authorization. O esquema "Bearer" é comparado sem diferenciar maiúsculas.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func (s *Server) respondUnauthorized(w http.ResponseWriter, r *http.Request, message string) {
+	slog.Warn("Requisição não autenticada", "path", r.URL.Path, "remote_addr", r.RemoteAddr, "reason", message)
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	s.respondWithError(w, http.StatusUnauthorized, message, nil)
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"api/internal/gateways"
+)
+
+func TestRequireAuth(t *testing.T) {
+	vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+		"kv/app": {"password": "s3cret"},
+	}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	tests := []struct {
+		name          string
+		header        string
+		wantStatus    int
+		wantChallenge bool
+	}{
+		{name: "missing header", wantStatus: http.StatusUnauthorized, wantChallenge: true},
+		{name: "not a bearer token", header: "Basic YWRtaW46YWRtaW4=", wantStatus: http.StatusUnauthorized, wantChallenge: true},
+		{name: "empty bearer token", header: "Bearer ", wantStatus: http.StatusUnauthorized, wantChallenge: true},
+		{name: "wrong token", header: "Bearer wrong-token", wantStatus: http.StatusUnauthorized, wantChallenge: true},
+		{name: "token prefix", header: "Bearer admin-toke", wantStatus: http.StatusUnauthorized, wantChallenge: true},
+		{name: "correct token", header: "Bearer admin-token", wantStatus: http.StatusOK},
+		{name: "scheme is case insensitive", header: "bearer admin-token", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRequest(http.MethodGet, "/api/v1/secrets/kv/app", "")
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			w := serve(s, r)
+			assertStatus(t, w, tt.wantStatus)
+
+			body := decodeBody(t, w)
+			if tt.wantStatus == http.StatusUnauthorized {
+				if _, ok := body["error"].(string); !ok {
+					t.Errorf("401 body = %v, want an error message", body)
+				}
+			} else if body["password"] != "s3cret" {
+				t.Errorf("body = %v", body)
+			}
+			if got := w.Header().Get("WWW-Authenticate") != ""; got != tt.wantChallenge {
+				t.Errorf("WWW-Authenticate present = %v, want %v", got, tt.wantChallenge)
+			}
+		})
+	}
+}
+
+func TestHealthIsUnauthenticated(t *testing.T) {
+	s := newTestServer(t, &gateways.Manager{VaultClient: &stubVaultClient{}}, testConfig())
+
+	w := doRequest(s, http.MethodGet, "/health", "", "")
+	assertStatus(t, w, http.StatusOK)
+	if body := decodeBody(t, w); body["status"] != "ok" {
+		t.Errorf("body = %v", body)
+	}
+
+	// Um token inválido também não deve afetar o health check.
+	assertStatus(t, doRequest(s, http.MethodGet, "/health", "wrong-token", ""), http.StatusOK)
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"api/internal/auth"
+	"net/http"
+
+	"api/proto/vault"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// handleGenerateDatabaseCredentials gera credenciais temporárias a partir de um papel do engine de
+// banco de dados. O engine usado é o montado em ?mount= (padrão "database"), e a política de segredos
+// é avaliada sobre o caminho "<mount>/creds/<role>" com a capacidade read.
+func (s *Server) handleGenerateDatabaseCredentials(w http.ResponseWriter, r *http.Request) {
+	mount := r.URL.Query().Get("mount")
+	if mount == "" {
+		mount = "database"
+	}
+	role := chi.URLParam(r, "role")
+	if _, ok := s.authorizeSecretPath(w, r, mount+"/creds/"+role, auth.CapabilityRead); !ok {
+		return
+	}
+
+	grpcRequest := &vault.GenerateDatabaseCredentialsRequest{Mount: mount, Role: role}
+	credentials, err := s.gatewayManager.VaultClient.GenerateDatabaseCredentials(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao gerar credenciais de banco de dados no Vault", err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	s.respondWithProto(w, http.StatusCreated, credentials)
+}
+
+type leasePayload struct {
+	LeaseID   string `json:"lease_id"`
+	Increment int32  `json:"increment"`
+}
+
+// handleRenewLease renova um lease. O ID do lease é o caminho em que foi emitido seguido de um
+// sufixo aleatório, então a política é avaliada sobre ele com a capacidade write.
+func (s *Server) handleRenewLease(w http.ResponseWriter, r *http.Request) {
+	var payload leasePayload
+	if !s.decodeJSONBody(w, r, &payload) {
+		return
+	}
+	leaseID, ok := s.authorizeSecretPath(w, r, payload.LeaseID, auth.CapabilityWrite)
+	if !ok {
+		return
+	}
+
+	grpcRequest := &vault.RenewLeaseRequest{LeaseId: leaseID, Increment: payload.Increment}
+	lease, err := s.gatewayManager.VaultClient.RenewLease(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao renovar lease no Vault", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, lease)
+}
+
+// handleRevokeLease revoga um lease, invalidando as credenciais emitidas com ele. Exige a capacidade
+// delete sobre o ID do lease.
+func (s *Server) handleRevokeLease(w http.ResponseWriter, r *http.Request) {
+	var payload leasePayload
+	if !s.decodeJSONBody(w, r, &payload) {
+		return
+	}
+	leaseID, ok := s.authorizeSecretPath(w, r, payload.LeaseID, auth.CapabilityDelete)
+	if !ok {
+		return
+	}
+
+	grpcRequest := &vault.RevokeLeaseRequest{LeaseId: leaseID}
+	if _, err := s.gatewayManager.VaultClient.RevokeLease(r.Context(), grpcRequest); err != nil {
+		s.respondWithGRPCError(w, "Erro ao revogar lease no Vault", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"api/internal/config"
+	"api/internal/gateways"
+)
+
+func TestLeaseRoutesRequireLeasesScope(t *testing.T) {
+	cfg := testConfig()
+	cfg.APICentral.APIKeys = append(cfg.APICentral.APIKeys,
+		config.APIKeyConfig{Name: "kv-writer", Token: "kv-writer-token", Scopes: []string{"secrets:write"}},
+		config.APIKeyConfig{Name: "db-app", Token: "db-app-token", Scopes: []string{"database:write", "leases:write"}},
+	)
+	cfg.APICentral.SecretPolicies = append(cfg.APICentral.SecretPolicies, config.SecretPolicyConfig{
+		Name:       "leases",
+		Identities: []string{"key:kv-writer", "key:db-app"},
+		Rules:      []config.SecretPolicyRule{{Path: "database/creds/app/*", Capabilities: []string{"write", "delete"}}},
+	})
+	body := `{"lease_id":"database/creds/app/abc123"}`
+
+	tests := []struct {
+		name       string
+		target     string
+		token      string
+		wantStatus int
+		wantCalls  []string
+	}{
+		{name: "renew with secrets scope", target: "/api/v1/leases/renew", token: "kv-writer-token", wantStatus: http.StatusForbidden},
+		{name: "revoke with secrets scope", target: "/api/v1/leases/revoke", token: "kv-writer-token", wantStatus: http.StatusForbidden},
+		{name: "renew with leases scope", target: "/api/v1/leases/renew", token: "db-app-token", wantStatus: http.StatusOK, wantCalls: []string{"RenewLease database/creds/app/abc123"}},
+		{name: "revoke with leases scope", target: "/api/v1/leases/revoke", token: "db-app-token", wantStatus: http.StatusOK, wantCalls: []string{"RevokeLease database/creds/app/abc123"}},
+		{name: "leases scope without policy", target: "/api/v1/leases/renew", token: "admin-token", wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, cfg)
+
+			assertStatus(t, doRequest(s, http.MethodPost, tt.target, tt.token, body), tt.wantStatus)
+			if !reflect.DeepEqual(vaultClient.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", vaultClient.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGenerateDatabaseCredentials(t *testing.T) {
+	cfg := testConfig()
+	cfg.APICentral.APIKeys = append(cfg.APICentral.APIKeys,
+		config.APIKeyConfig{Name: "db-app", Token: "db-app-token", Scopes: []string{"database:write", "leases:write"}},
+	)
+	cfg.APICentral.SecretPolicies = append(cfg.APICentral.SecretPolicies, config.SecretPolicyConfig{
+		Name:       "database",
+		Identities: []string{"key:db-app"},
+		Rules: []config.SecretPolicyRule{
+			{Path: "database/creds/app", Capabilities: []string{"read"}},
+			{Path: "database/creds/app/*", Capabilities: []string{"write"}},
+		},
+	})
+	vaultClient := &stubVaultClient{}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, cfg)
+
+	w := doRequest(s, http.MethodPost, "/api/v1/database/creds/app", "db-app-token", "")
+	assertStatus(t, w, http.StatusCreated)
+	if cacheControl := w.Header().Get("Cache-Control"); cacheControl != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", cacheControl)
+	}
+	want := map[string]interface{}{
+		"request_id":     "req-1",
+		"lease_id":       "database/creds/app/abc123",
+		"renewable":      true,
+		"lease_duration": float64(3600),
+		"username":       "v-app-abc123",
+		"password":       "s3cr3t",
+	}
+	if body := decodeBody(t, w); !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %v, want %v", body, want)
+	}
+
+	w = doRequest(s, http.MethodPost, "/api/v1/leases/renew", "db-app-token", `{"lease_id":"database/creds/app/abc123","increment":1800}`)
+	assertStatus(t, w, http.StatusOK)
+	want = map[string]interface{}{"lease_id": "database/creds/app/abc123", "renewable": true, "lease_duration": float64(1800)}
+	if body := decodeBody(t, w); !reflect.DeepEqual(body, want) {
+		t.Errorf("renew body = %v, want %v", body, want)
+	}
+	assertStatus(t, doRequest(s, http.MethodPost, "/api/v1/database/creds/other", "db-app-token", ""), http.StatusForbidden)
+}
@@ -0,0 +1,739 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"api/proto/dcim_proto"
+	"api/proto/ipam"
+	"api/proto/organization"
+	"api/proto/virtualization"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// registerNetboxRoutes registra o CRUD de cada recurso. As atualizações são parciais, com PATCH:
+// campos omitidos ou vazios mantêm o valor atual no NetBox. PUT não é aceito (405), pois o gateway
+// não tem como distinguir um campo omitido de um campo a ser limpo.
+func (s *Server) registerNetboxRoutes(r chi.Router) {
+	r.Use(s.requireScope("netbox"))
+	r.Route("/tenants", func(r chi.Router) {
+		r.Get("/", s.handleListTenants)
+		r.Post("/", s.handleCreateTenant)
+		r.Get("/{id}", s.handleGetTenant)
+		r.Patch("/{id}", s.handleUpdateTenant)
+		r.Delete("/{id}", s.handleDeleteTenant)
+	})
+	r.Route("/sites", func(r chi.Router) {
+		r.Get("/", s.handleListSites)
+		r.Post("/", s.handleCreateSite)
+		r.Get("/{id}", s.handleGetSite)
+		r.Patch("/{id}", s.handleUpdateSite)
+		r.Delete("/{id}", s.handleDeleteSite)
+	})
+	r.Route("/racks", func(r chi.Router) {
+		r.Get("/", s.handleListRacks)
+		r.Post("/", s.handleCreateRack)
+		r.Get("/{id}", s.handleGetRack)
+		r.Patch("/{id}", s.handleUpdateRack)
+		r.Delete("/{id}", s.handleDeleteRack)
+	})
+	r.Route("/devices", func(r chi.Router) {
+		r.Get("/", s.handleListDevices)
+		r.Post("/", s.handleCreateDevice)
+		r.Get("/{id}", s.handleGetDevice)
+		r.Patch("/{id}", s.handleUpdateDevice)
+		r.Delete("/{id}", s.handleDeleteDevice)
+	})
+	r.Route("/prefixes", func(r chi.Router) {
+		r.Get("/", s.handleListPrefixes)
+		r.Post("/", s.handleCreatePrefix)
+		r.Get("/{id}", s.handleGetPrefix)
+		r.Patch("/{id}", s.handleUpdatePrefix)
+		r.Delete("/{id}", s.handleDeletePrefix)
+	})
+	r.Route("/ip-addresses", func(r chi.Router) {
+		r.Get("/", s.handleListIPAddresses)
+		r.Post("/", s.handleCreateIPAddress)
+		r.Get("/{id}", s.handleGetIPAddress)
+		r.Patch("/{id}", s.handleUpdateIPAddress)
+		r.Delete("/{id}", s.handleDeleteIPAddress)
+	})
+	r.Route("/vlans", func(r chi.Router) {
+		r.Get("/", s.handleListVLANs)
+		r.Post("/", s.handleCreateVLAN)
+		r.Get("/{id}", s.handleGetVLAN)
+		r.Patch("/{id}", s.handleUpdateVLAN)
+		r.Delete("/{id}", s.handleDeleteVLAN)
+	})
+	r.Route("/clusters", func(r chi.Router) {
+		r.Get("/", s.handleListClusters)
+		r.Post("/", s.handleCreateCluster)
+		r.Get("/{id}", s.handleGetCluster)
+		r.Patch("/{id}", s.handleUpdateCluster)
+		r.Delete("/{id}", s.handleDeleteCluster)
+	})
+	r.Route("/virtual-machines", func(r chi.Router) {
+		r.Get("/", s.handleListVirtualMachines)
+		r.Post("/", s.handleCreateVirtualMachine)
+		r.Get("/{id}", s.handleGetVirtualMachine)
+		r.Patch("/{id}", s.handleUpdateVirtualMachine)
+		r.Delete("/{id}", s.handleDeleteVirtualMachine)
+	})
+}
+
+// parseListParams lê os parâmetros de paginação "limit" e "offset" da query string.
+func parseListParams(r *http.Request) (int64, int64, error) {
+	var limit, offset int64
+	var err error
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.ParseInt(v, 10, 64); err != nil || limit < 0 {
+			return 0, 0, fmt.Errorf("parâmetro 'limit' inválido")
+		}
+	}
+	if v := r.URL.Query().Get("offset"); v != "" {
+		if offset, err = strconv.ParseInt(v, 10, 64); err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("parâmetro 'offset' inválido")
+		}
+	}
+	return limit, offset, nil
+}
+
+func parseIDParam(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("ID inválido")
+	}
+	return id, nil
+}
+
+func (s *Server) decodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleListTenants(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Organization.ListTenants(r.Context(), &organization.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar tenants do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	tenant, err := s.gatewayManager.NetboxClient.Organization.GetTenant(r.Context(), &organization.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar tenant no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, tenant)
+}
+func (s *Server) handleCreateTenant(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest organization.CreateTenantRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	tenant, err := s.gatewayManager.NetboxClient.Organization.CreateTenant(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar tenant no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, tenant)
+}
+func (s *Server) handleUpdateTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest organization.Tenant
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	tenant, err := s.gatewayManager.NetboxClient.Organization.UpdateTenant(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar tenant no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, tenant)
+}
+func (s *Server) handleDeleteTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Organization.DeleteTenant(r.Context(), &organization.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover tenant do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListSites(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Organization.ListSites(r.Context(), &organization.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar sites do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetSite(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	site, err := s.gatewayManager.NetboxClient.Organization.GetSite(r.Context(), &organization.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar site no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, site)
+}
+func (s *Server) handleCreateSite(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest organization.CreateSiteRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	site, err := s.gatewayManager.NetboxClient.Organization.CreateSite(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar site no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, site)
+}
+func (s *Server) handleUpdateSite(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest organization.Site
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	site, err := s.gatewayManager.NetboxClient.Organization.UpdateSite(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar site no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, site)
+}
+func (s *Server) handleDeleteSite(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Organization.DeleteSite(r.Context(), &organization.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover site do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListRacks(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Dcim.ListRacks(r.Context(), &dcim_proto.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar racks do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetRack(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	rack, err := s.gatewayManager.NetboxClient.Dcim.GetRack(r.Context(), &dcim_proto.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar rack no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, rack)
+}
+func (s *Server) handleCreateRack(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest dcim_proto.CreateRackRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	rack, err := s.gatewayManager.NetboxClient.Dcim.CreateRack(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar rack no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, rack)
+}
+func (s *Server) handleUpdateRack(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest dcim_proto.Rack
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	rack, err := s.gatewayManager.NetboxClient.Dcim.UpdateRack(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar rack no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, rack)
+}
+func (s *Server) handleDeleteRack(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Dcim.DeleteRack(r.Context(), &dcim_proto.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover rack do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListDevices(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Dcim.ListDevices(r.Context(), &dcim_proto.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar devices do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetDevice(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	device, err := s.gatewayManager.NetboxClient.Dcim.GetDevice(r.Context(), &dcim_proto.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar device no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, device)
+}
+func (s *Server) handleCreateDevice(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest dcim_proto.CreateDeviceRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	device, err := s.gatewayManager.NetboxClient.Dcim.CreateDevice(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar device no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, device)
+}
+func (s *Server) handleUpdateDevice(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest dcim_proto.Device
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	device, err := s.gatewayManager.NetboxClient.Dcim.UpdateDevice(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar device no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, device)
+}
+func (s *Server) handleDeleteDevice(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Dcim.DeleteDevice(r.Context(), &dcim_proto.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover device do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListPrefixes(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Ipam.ListPrefixes(r.Context(), &ipam.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar prefixos do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetPrefix(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	prefix, err := s.gatewayManager.NetboxClient.Ipam.GetPrefix(r.Context(), &ipam.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar prefixo no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, prefix)
+}
+func (s *Server) handleCreatePrefix(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest ipam.CreatePrefixRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	prefix, err := s.gatewayManager.NetboxClient.Ipam.CreatePrefix(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar prefixo no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, prefix)
+}
+func (s *Server) handleUpdatePrefix(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest ipam.Prefix
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	prefix, err := s.gatewayManager.NetboxClient.Ipam.UpdatePrefix(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar prefixo no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, prefix)
+}
+func (s *Server) handleDeletePrefix(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Ipam.DeletePrefix(r.Context(), &ipam.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover prefixo do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListIPAddresses(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Ipam.ListIPAddresses(r.Context(), &ipam.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar endereços IP do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetIPAddress(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	address, err := s.gatewayManager.NetboxClient.Ipam.GetIPAddress(r.Context(), &ipam.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar endereço IP no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, address)
+}
+func (s *Server) handleCreateIPAddress(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest ipam.CreateIPAddressRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	address, err := s.gatewayManager.NetboxClient.Ipam.CreateIPAddress(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar endereço IP no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, address)
+}
+func (s *Server) handleUpdateIPAddress(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest ipam.IPAddress
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	address, err := s.gatewayManager.NetboxClient.Ipam.UpdateIPAddress(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar endereço IP no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, address)
+}
+func (s *Server) handleDeleteIPAddress(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Ipam.DeleteIPAddress(r.Context(), &ipam.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover endereço IP do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListVLANs(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Ipam.ListVLANs(r.Context(), &ipam.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar VLANs do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetVLAN(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	vlan, err := s.gatewayManager.NetboxClient.Ipam.GetVLAN(r.Context(), &ipam.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar VLAN no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, vlan)
+}
+func (s *Server) handleCreateVLAN(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest ipam.CreateVLANRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	vlan, err := s.gatewayManager.NetboxClient.Ipam.CreateVLAN(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar VLAN no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, vlan)
+}
+func (s *Server) handleUpdateVLAN(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest ipam.VLAN
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	vlan, err := s.gatewayManager.NetboxClient.Ipam.UpdateVLAN(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar VLAN no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, vlan)
+}
+func (s *Server) handleDeleteVLAN(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Ipam.DeleteVLAN(r.Context(), &ipam.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover VLAN do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListClusters(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Virtualization.ListClusters(r.Context(), &virtualization.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar clusters do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetCluster(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	cluster, err := s.gatewayManager.NetboxClient.Virtualization.GetCluster(r.Context(), &virtualization.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar cluster no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, cluster)
+}
+func (s *Server) handleCreateCluster(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest virtualization.CreateClusterRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	cluster, err := s.gatewayManager.NetboxClient.Virtualization.CreateCluster(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar cluster no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, cluster)
+}
+func (s *Server) handleUpdateCluster(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest virtualization.Cluster
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	cluster, err := s.gatewayManager.NetboxClient.Virtualization.UpdateCluster(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar cluster no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, cluster)
+}
+func (s *Server) handleDeleteCluster(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Virtualization.DeleteCluster(r.Context(), &virtualization.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover cluster do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleListVirtualMachines(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parseListParams(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	response, err := s.gatewayManager.NetboxClient.Virtualization.ListVirtualMachines(r.Context(), &virtualization.ListRequest{Limit: limit, Offset: offset})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao listar VMs do NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+func (s *Server) handleGetVirtualMachine(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	vm, err := s.gatewayManager.NetboxClient.Virtualization.GetVirtualMachine(r.Context(), &virtualization.GetRequest{Id: id})
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao buscar VM no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, vm)
+}
+func (s *Server) handleCreateVirtualMachine(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest virtualization.CreateVMRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	vm, err := s.gatewayManager.NetboxClient.Virtualization.CreateVirtualMachine(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao criar VM no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusCreated, vm)
+}
+func (s *Server) handleUpdateVirtualMachine(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	var grpcRequest virtualization.VirtualMachine
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	grpcRequest.Id = id
+	vm, err := s.gatewayManager.NetboxClient.Virtualization.UpdateVirtualMachine(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Falha ao atualizar VM no NetBox", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, vm)
+}
+func (s *Server) handleDeleteVirtualMachine(w http.ResponseWriter, r *http.Request) {
+	id, err := parseIDParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if _, err := s.gatewayManager.NetboxClient.Virtualization.DeleteVirtualMachine(r.Context(), &virtualization.GetRequest{Id: id}); err != nil {
+		s.respondWithGRPCError(w, "Falha ao remover VM do NetBox", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"api/internal/gateways"
+	netbox_client "api/internal/grpcclients/netbox"
+	"api/proto/dcim_proto"
+	"api/proto/ipam"
+	"api/proto/organization"
+	"api/proto/virtualization"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// fakeNetboxGateway registra os serviços do NetBox Gateway sem implementação: um interceptor guarda
+// cada chamada e responde com uma mensagem vazia, que o cliente lê como o valor zero da resposta.
+// O ID 404 responde NotFound. Com response definido, ele é devolvido no lugar da mensagem vazia.
+type fakeNetboxGateway struct {
+	mu       sync.Mutex
+	methods  []string
+	requests []proto.Message
+	response proto.Message
+}
+
+func (g *fakeNetboxGateway) intercept(_ context.Context, req interface{}, info *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	message := req.(proto.Message)
+	g.mu.Lock()
+	g.methods = append(g.methods, info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:])
+	g.requests = append(g.requests, message)
+	g.mu.Unlock()
+	if id, ok := protoField(message, "id").(int64); ok && id == 404 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if g.response != nil {
+		return g.response, nil
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func newNetboxTestServer(t *testing.T) (*Server, *fakeNetboxGateway) {
+	t.Helper()
+	gateway := &fakeNetboxGateway{}
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(gateway.intercept))
+	organization.RegisterOrganizationServiceServer(grpcServer, organization.UnimplementedOrganizationServiceServer{})
+	dcim_proto.RegisterDcimServiceServer(grpcServer, dcim_proto.UnimplementedDcimServiceServer{})
+	ipam.RegisterIpamServiceServer(grpcServer, ipam.UnimplementedIpamServiceServer{})
+	virtualization.RegisterVirtualizationServiceServer(grpcServer, virtualization.UnimplementedVirtualizationServiceServer{})
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///netbox-gateway",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	client := &netbox_client.Client{
+		Organization:   organization.NewOrganizationServiceClient(conn),
+		Dcim:           dcim_proto.NewDcimServiceClient(conn),
+		Ipam:           ipam.NewIpamServiceClient(conn),
+		Virtualization: virtualization.NewVirtualizationServiceClient(conn),
+	}
+	return newTestServer(t, &gateways.Manager{NetboxClient: client}, testConfig()), gateway
+}
+
+// protoField devolve o valor do campo name da mensagem, ou nil se ela não tiver esse campo.
+func protoField(message proto.Message, name string) interface{} {
+	field := message.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		return nil
+	}
+	return message.ProtoReflect().Get(field).Interface()
+}
+
+func TestNetboxCRUD(t *testing.T) {
+	resources := []struct {
+		path   string
+		name   string // nome dos métodos no gateway, como em "ListTenants" e "GetTenant"
+		plural string
+		field  string // campo enviado no corpo de POST e PATCH
+		value  string
+	}{
+		{path: "tenants", name: "Tenant", plural: "Tenants", field: "name", value: "acme"},
+		{path: "sites", name: "Site", plural: "Sites", field: "name", value: "dc-1"},
+		{path: "racks", name: "Rack", plural: "Racks", field: "name", value: "r-01"},
+		{path: "devices", name: "Device", plural: "Devices", field: "name", value: "sw-01"},
+		{path: "prefixes", name: "Prefix", plural: "Prefixes", field: "prefix", value: "10.0.0.0/24"},
+		{path: "ip-addresses", name: "IPAddress", plural: "IPAddresses", field: "address", value: "10.0.0.1/24"},
+		{path: "vlans", name: "VLAN", plural: "VLANs", field: "name", value: "servers"},
+		{path: "clusters", name: "Cluster", plural: "Clusters", field: "name", value: "prod"},
+		{path: "virtual-machines", name: "VirtualMachine", plural: "VirtualMachines", field: "name", value: "vm-01"},
+	}
+	for _, resource := range resources {
+		t.Run(resource.path, func(t *testing.T) {
+			base := "/api/v1/netbox/" + resource.path
+			body := `{"` + resource.field + `":"` + resource.value + `"}`
+			tests := []struct {
+				name       string
+				method     string
+				target     string
+				body       string
+				wantStatus int
+				wantMethod string
+				wantFields map[string]interface{}
+			}{
+				{name: "list", method: http.MethodGet, target: base + "/?limit=10&offset=20", wantStatus: http.StatusOK, wantMethod: "List" + resource.plural, wantFields: map[string]interface{}{"limit": int64(10), "offset": int64(20)}},
+				{name: "get", method: http.MethodGet, target: base + "/7", wantStatus: http.StatusOK, wantMethod: "Get" + resource.name, wantFields: map[string]interface{}{"id": int64(7)}},
+				{name: "create", method: http.MethodPost, target: base + "/", body: body, wantStatus: http.StatusCreated, wantMethod: "Create" + resource.name, wantFields: map[string]interface{}{resource.field: resource.value}},
+				{name: "patch", method: http.MethodPatch, target: base + "/7", body: body, wantStatus: http.StatusOK, wantMethod: "Update" + resource.name, wantFields: map[string]interface{}{"id": int64(7), resource.field: resource.value}},
+				{name: "patch cannot change id", method: http.MethodPatch, target: base + "/7", body: `{"id":8}`, wantStatus: http.StatusOK, wantMethod: "Update" + resource.name, wantFields: map[string]interface{}{"id": int64(7)}},
+				{name: "delete", method: http.MethodDelete, target: base + "/7", wantStatus: http.StatusOK, wantMethod: "Delete" + resource.name, wantFields: map[string]interface{}{"id": int64(7)}},
+				{name: "put is not a partial update", method: http.MethodPut, target: base + "/7", body: body, wantStatus: http.StatusMethodNotAllowed},
+				{name: "invalid id", method: http.MethodGet, target: base + "/abc", wantStatus: http.StatusBadRequest},
+				{name: "invalid limit", method: http.MethodGet, target: base + "/?limit=-1", wantStatus: http.StatusBadRequest},
+				{name: "invalid body", method: http.MethodPatch, target: base + "/7", body: `{`, wantStatus: http.StatusBadRequest},
+				{name: "not found", method: http.MethodGet, target: base + "/404", wantStatus: http.StatusNotFound, wantMethod: "Get" + resource.name, wantFields: map[string]interface{}{"id": int64(404)}},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					s, gateway := newNetboxTestServer(t)
+					assertStatus(t, doRequest(s, tt.method, tt.target, "admin-token", tt.body), tt.wantStatus)
+
+					if tt.wantMethod == "" {
+						if len(gateway.methods) != 0 {
+							t.Errorf("gateway calls = %v, want none", gateway.methods)
+						}
+						return
+					}
+					if len(gateway.methods) != 1 || gateway.methods[0] != tt.wantMethod {
+						t.Fatalf("gateway calls = %v, want [%s]", gateway.methods, tt.wantMethod)
+					}
+					for field, want := range tt.wantFields {
+						if got := protoField(gateway.requests[0], field); got != want {
+							t.Errorf("%s = %v, want %v", field, got, want)
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestNetboxRequiresScope(t *testing.T) {
+	s, gateway := newNetboxTestServer(t)
+	assertStatus(t, doRequest(s, http.MethodGet, "/api/v1/netbox/tenants/", "reader-token", ""), http.StatusForbidden)
+	assertStatus(t, doRequest(s, http.MethodGet, "/api/v1/netbox/tenants/", "", ""), http.StatusUnauthorized)
+	if len(gateway.methods) != 0 {
+		t.Errorf("gateway calls = %v, want none", gateway.methods)
+	}
+}
+
+// O corpo e a resposta seguem o mapeamento JSON do protobuf: os nomes do .proto e os json_name são
+// aceitos, int64 pode vir como string e sai como string, e campos desconhecidos são recusados.
+func TestNetboxProtoJSON(t *testing.T) {
+	s, gateway := newNetboxTestServer(t)
+	gateway.response = &dcim_proto.Device{Id: 9007199254740993, Name: "sw-01", SiteId: 5}
+
+	rr := doRequest(s, http.MethodPost, "/api/v1/netbox/devices/", "admin-token", `{"name":"sw-01","deviceTypeId":"3","role_id":4}`)
+	assertStatus(t, rr, http.StatusCreated)
+	request := gateway.requests[0].(*dcim_proto.CreateDeviceRequest)
+	if request.GetDeviceTypeId() != 3 || request.GetRoleId() != 4 {
+		t.Errorf("request = %v", request)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"id": "9007199254740993", "name": "sw-01", "site_id": "5"}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %v, want %v", body, want)
+	}
+
+	assertStatus(t, doRequest(s, http.MethodPost, "/api/v1/netbox/devices/", "admin-token", `{"nmae":"sw-01"}`), http.StatusBadRequest)
+	if len(gateway.methods) != 1 {
+		t.Errorf("gateway calls = %v, want only the first create", gateway.methods)
+	}
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"api/internal/auth"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"api/proto/vault"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// pkiMount retorna o engine PKI indicado em ?mount= (padrão "pki").
+func pkiMount(r *http.Request) string {
+	if mount := r.URL.Query().Get("mount"); mount != "" {
+		return mount
+	}
+	return "pki"
+}
+
+// handleIssueCertificate emite um certificado pelo papel PKI da URL. O acesso é controlado por papel:
+// a política de segredos precisa conceder write sobre "<mount>/issue/<role>".
+func (s *Server) handleIssueCertificate(w http.ResponseWriter, r *http.Request) {
+	mount, role := pkiMount(r), chi.URLParam(r, "role")
+	if _, ok := s.authorizeSecretPath(w, r, mount+"/issue/"+role, auth.CapabilityWrite); !ok {
+		return
+	}
+	var grpcRequest vault.IssueCertificateRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	if err := validateIssueRequest(&grpcRequest); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	grpcRequest.Mount, grpcRequest.Role = mount, role
+
+	certificate, err := s.gatewayManager.VaultClient.IssueCertificate(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao emitir certificado no Vault", err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	s.respondWithProto(w, http.StatusCreated, certificate)
+}
+
+// validateIssueRequest confere o formato do pedido; quais nomes e TTLs são permitidos é decidido pelo papel
+// no Vault. Os SANs são enviados ao Vault separados por vírgula, então nenhum nome pode conter uma.
+func validateIssueRequest(request *vault.IssueCertificateRequest) error {
+	if strings.TrimSpace(request.GetCommonName()) == "" {
+		return fmt.Errorf("O campo 'common_name' é obrigatório")
+	}
+	sans := map[string][]string{"alt_names": request.GetAltNames(), "ip_sans": request.GetIpSans(), "uri_sans": request.GetUriSans()}
+	for field, names := range sans {
+		for _, name := range names {
+			if strings.TrimSpace(name) == "" || strings.Contains(name, ",") {
+				return fmt.Errorf("Campo '%s' inválido: %q", field, name)
+			}
+		}
+	}
+	if ttl := request.GetTtl(); ttl != "" && !validTTL(ttl) {
+		return fmt.Errorf("Campo 'ttl' inválido: use segundos ou uma duração como \"72h\" ou \"30d\"")
+	}
+	return nil
+}
+
+// validTTL aceita os formatos de duração do Vault: segundos, durações do Go ("90m", "72h") e dias ("30d").
+func validTTL(ttl string) bool {
+	if days, ok := strings.CutSuffix(ttl, "d"); ok {
+		n, err := strconv.Atoi(days)
+		return err == nil && n > 0
+	}
+	if seconds, err := strconv.Atoi(ttl); err == nil {
+		return seconds > 0
+	}
+	duration, err := time.ParseDuration(ttl)
+	return err == nil && duration > 0
+}
+
+// handleRevokeCertificate revoga um certificado pelo número de série. O corpo indica também o papel que
+// emitiu o certificado: a política é avaliada sobre "<mount>/roles/<role>" com a capacidade delete, e o
+// gateway só revoga se o certificado com aquele serial puder ter sido emitido pelo papel.
+func (s *Server) handleRevokeCertificate(w http.ResponseWriter, r *http.Request) {
+	var grpcRequest vault.RevokeCertificateRequest
+	if !s.decodeProtoBody(w, r, &grpcRequest) {
+		return
+	}
+	role := grpcRequest.GetRole()
+	if role == "" {
+		s.respondWithError(w, http.StatusBadRequest, "O campo 'role' é obrigatório", nil)
+		return
+	}
+	if strings.Contains(role, "/") {
+		s.respondWithError(w, http.StatusBadRequest, "Campo 'role' inválido", nil)
+		return
+	}
+	mount := pkiMount(r)
+	if _, ok := s.authorizeSecretPath(w, r, mount+"/roles/"+role, auth.CapabilityDelete); !ok {
+		return
+	}
+	grpcRequest.Mount = mount
+
+	response, err := s.gatewayManager.VaultClient.RevokeCertificate(r.Context(), &grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao revogar certificado no Vault", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+
+// handleGetCACertificate e handleGetCRL publicam material público da CA; basta o escopo pki:read.
+func (s *Server) handleGetCACertificate(w http.ResponseWriter, r *http.Request) {
+	grpcRequest := &vault.GetCACertificateRequest{Mount: pkiMount(r)}
+	response, err := s.gatewayManager.VaultClient.GetCACertificate(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao buscar certificado da CA no Vault", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
+
+func (s *Server) handleGetCRL(w http.ResponseWriter, r *http.Request) {
+	grpcRequest := &vault.GetCRLRequest{Mount: pkiMount(r)}
+	response, err := s.gatewayManager.VaultClient.GetCRL(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao buscar CRL no Vault", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, response)
+}
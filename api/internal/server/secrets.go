@@ -0,0 +1,348 @@
+package server
+
+import (
+	"api/internal/auth"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"api/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (s *Server) handleReadOrListSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// A listagem é pedida explicitamente com ?list=true, a árvore recursiva com ?tree=true e o histórico
+	// de versões com ?view=versions (?versions é a lista de versões do DELETE); em KV v2 o gateway lista
+	// o endpoint de metadados, então tanto o caminho data/ quanto o metadata/ de uma pasta podem ser
+	// usados.
+	view, err := secretView(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	switch view {
+	case "tree":
+		s.handleSecretTree(w, r)
+		return
+	case "versions":
+		s.handleSecretVersions(w, r)
+		return
+	}
+	capability := auth.CapabilityRead
+	if view == "list" {
+		capability = auth.CapabilityList
+	}
+	vaultPath, ok := s.authorizeSecret(w, r, capability)
+	if !ok {
+		return
+	}
+
+	if capability == auth.CapabilityList {
+		grpcRequest := &vault.ListSecretsRequest{Path: vaultPath}
+
+		response, err := s.gatewayManager.VaultClient.ListSecrets(ctx, grpcRequest)
+		if err != nil {
+			s.respondWithGRPCError(w, "Erro ao listar segredos do Vault", err)
+			return
+		}
+		s.respondWithJSON(w, http.StatusOK, response.GetKeys())
+		return
+	}
+
+	version, err := parseVersionParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	grpcRequest := &vault.ReadSecretRequest{Path: vaultPath, Version: version}
+
+	secret, err := s.gatewayManager.VaultClient.ReadSecret(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao ler segredo do Vault", err)
+		return
+	}
+	secretData := secret.GetData().AsMap()
+	if version := secret.GetVersionMetadata().GetVersion(); version > 0 {
+		w.Header().Set("ETag", secretETag(version))
+	}
+	s.respondWithJSON(w, http.StatusOK, secretData)
+}
+
+// handlePostSecret escreve o segredo ou, com ?action=undelete ou ?action=destroy, executa a ação
+// sobre as versões indicadas no corpo. As ações ficam na query para não disputar o caminho com
+// segredos de mesmo nome.
+func (s *Server) handlePostSecret(w http.ResponseWriter, r *http.Request) {
+	switch action := r.URL.Query().Get("action"); action {
+	case "":
+		s.handleWriteSecret(w, r)
+	case "undelete":
+		s.handleUndeleteSecret(w, r)
+	case "destroy":
+		s.handleDestroySecretVersions(w, r)
+	default:
+		s.respondWithError(w, http.StatusBadRequest, "Ação '"+action+"' inválida", nil)
+	}
+}
+
+func (s *Server) handleWriteSecret(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityWrite)
+	if !ok {
+		return
+	}
+	cas, ok := parseIfMatch(r)
+	if !ok {
+		s.respondWithError(w, http.StatusPreconditionFailed, "Cabeçalho If-Match inválido", nil)
+		return
+	}
+	var payload map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
+		return
+	}
+
+	grpcPayload, err := structpb.NewStruct(map[string]interface{}{"data": payload})
+	if err != nil {
+		s.respondWithError(w, http.StatusInternalServerError, "Erro interno ao converter payload", err)
+		return
+	}
+	grpcRequest := &vault.WriteSecretRequest{Path: vaultPath, Data: grpcPayload, Cas: cas}
+
+	response, err := s.gatewayManager.VaultClient.WriteSecret(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithWriteError(w, "Erro ao escrever segredo no Vault", err, cas != nil)
+		return
+	}
+	if response.GetVersion() > 0 {
+		w.Header().Set("ETag", secretETag(response.GetVersion()))
+	}
+	s.respondWithJSON(w, http.StatusCreated, map[string]string{"status": "success"})
+}
+
+// handlePatchSecret aplica o corpo como JSON merge patch (RFC 7396) sobre a versão atual do segredo:
+// chaves com valor null são removidas. O patch é feito pelo Vault numa única operação; com If-Match,
+// só é aplicado se a versão atual for a indicada. O corpo deve ser application/merge-patch+json ou
+// application/json.
+func (s *Server) handlePatchSecret(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityWrite)
+	if !ok {
+		return
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/merge-patch+json" && mediaType != "application/json" {
+		s.respondWithError(w, http.StatusUnsupportedMediaType, "O PATCH exige Content-Type application/merge-patch+json", nil)
+		return
+	}
+	cas, ok := parseIfMatch(r)
+	if !ok {
+		s.respondWithError(w, http.StatusPreconditionFailed, "Cabeçalho If-Match inválido", nil)
+		return
+	}
+
+	var patchData map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patchData); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
+		return
+	}
+	grpcPayload, err := structpb.NewStruct(patchData)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
+		return
+	}
+
+	grpcRequest := &vault.PatchSecretRequest{Path: vaultPath, Data: grpcPayload, Cas: cas}
+	response, err := s.gatewayManager.VaultClient.PatchSecret(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithWriteError(w, "Erro ao atualizar segredo no Vault", err, cas != nil)
+		return
+	}
+	if response.GetVersion() > 0 {
+		w.Header().Set("ETag", secretETag(response.GetVersion()))
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+// handleDeleteSecret remove (soft delete do KV v2) a versão mais recente do segredo ou, com
+// ?versions=1,2, as versões indicadas. As versões removidas podem ser recuperadas com ?action=undelete.
+func (s *Server) handleDeleteSecret(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityDelete)
+	if !ok {
+		return
+	}
+	versions, err := parseVersionsParam(r)
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	grpcRequest := &vault.DeleteSecretRequest{Path: vaultPath, Versions: versions}
+	if _, err := s.gatewayManager.VaultClient.DeleteSecret(r.Context(), grpcRequest); err != nil {
+		s.respondWithGRPCError(w, "Erro ao remover segredo do Vault", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+func (s *Server) handleUndeleteSecret(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityWrite)
+	if !ok {
+		return
+	}
+	var payload secretVersionsPayload
+	if !s.decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	grpcRequest := &vault.UndeleteSecretRequest{Path: vaultPath, Versions: payload.Versions}
+	if _, err := s.gatewayManager.VaultClient.UndeleteSecret(r.Context(), grpcRequest); err != nil {
+		s.respondWithGRPCError(w, "Erro ao restaurar versões do segredo no Vault", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+// handleDestroySecretVersions apaga de forma permanente os dados das versões indicadas.
+func (s *Server) handleDestroySecretVersions(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityDestroy)
+	if !ok {
+		return
+	}
+	var payload secretVersionsPayload
+	if !s.decodeJSONBody(w, r, &payload) {
+		return
+	}
+
+	grpcRequest := &vault.DestroySecretVersionsRequest{Path: vaultPath, Versions: payload.Versions}
+	if _, err := s.gatewayManager.VaultClient.DestroySecretVersions(r.Context(), grpcRequest); err != nil {
+		s.respondWithGRPCError(w, "Erro ao destruir versões do segredo no Vault", err)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
+}
+
+type secretVersionsPayload struct {
+	Versions []int32 `json:"versions"`
+}
+
+// handleSecretVersions responde com o histórico de versões e os metadados do segredo (KV v2).
+func (s *Server) handleSecretVersions(w http.ResponseWriter, r *http.Request) {
+	vaultPath, ok := s.authorizeSecret(w, r, auth.CapabilityRead)
+	if !ok {
+		return
+	}
+
+	grpcRequest := &vault.GetSecretMetadataRequest{Path: vaultPath}
+	metadata, err := s.gatewayManager.VaultClient.GetSecretMetadata(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao ler metadados do segredo no Vault", err)
+		return
+	}
+	s.respondWithProto(w, http.StatusOK, metadata)
+}
+
+// respondWithWriteError trata a falha de CAS do Vault: 412 quando o cliente enviou If-Match;
+// sem o cabeçalho, o conflito segue o mapeamento padrão (409).
+func (s *Server) respondWithWriteError(w http.ResponseWriter, message string, err error, conditional bool) {
+	if conditional && status.Code(err) == codes.FailedPrecondition {
+		s.respondWithError(w, http.StatusPreconditionFailed, "A versão do segredo não corresponde ao If-Match", nil)
+		return
+	}
+	s.respondWithGRPCError(w, message, err)
+}
+
+// secretETag representa a versão KV v2 do segredo como ETag forte.
+func secretETag(version int32) string {
+	return `"` + strconv.Itoa(int(version)) + `"`
+}
+
+// parseIfMatch converte o If-Match em versão para CAS. Sem o cabeçalho, ou com "*", retorna nil;
+// ok é false quando o valor não é um ETag de versão.
+func parseIfMatch(r *http.Request) (*int32, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, true
+	}
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return nil, false
+	}
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 32)
+	if err != nil || version < 0 {
+		return nil, false
+	}
+	cas := int32(version)
+	return &cas, true
+}
+
+// secretView identifica a visão pedida no GET: ?list=true, ?tree=true ou ?view=versions. Sem
+// nenhuma delas, retorna "" (leitura do segredo); mais de uma é um erro.
+func secretView(r *http.Request) (string, error) {
+	view := ""
+	switch value := r.URL.Query().Get("view"); value {
+	case "":
+	case "versions":
+		view = value
+	default:
+		return "", fmt.Errorf("Parâmetro 'view' inválido")
+	}
+	for _, name := range []string{"list", "tree"} {
+		flag, err := parseBoolParam(r, name)
+		if err != nil {
+			return "", err
+		}
+		if !flag {
+			continue
+		}
+		if view != "" {
+			return "", fmt.Errorf("Os parâmetros '%s' e '%s' não podem ser usados juntos", view, name)
+		}
+		view = name
+	}
+	return view, nil
+}
+
+// parseBoolParam lê um parâmetro booleano da query. Sem o parâmetro, retorna false.
+func parseBoolParam(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("Parâmetro '%s' inválido", name)
+	}
+	return flag, nil
+}
+
+// parseVersionParam lê ?version=N. Sem o parâmetro, retorna 0 (versão mais recente).
+func parseVersionParam(r *http.Request) (int32, error) {
+	value := r.URL.Query().Get("version")
+	if value == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("Parâmetro 'version' inválido")
+	}
+	return int32(version), nil
+}
+
+// parseVersionsParam lê ?versions=1,2 (ou o parâmetro repetido). Sem o parâmetro, retorna nil.
+func parseVersionsParam(r *http.Request) ([]int32, error) {
+	var versions []int32
+	for _, value := range r.URL.Query()["versions"] {
+		for _, item := range strings.Split(value, ",") {
+			version, err := strconv.ParseInt(strings.TrimSpace(item), 10, 32)
+			if err != nil || version <= 0 {
+				return nil, fmt.Errorf("Parâmetro 'versions' inválido")
+			}
+			versions = append(versions, int32(version))
+		}
+	}
+	return versions, nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"api/internal/auth"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/metadata"
+)
+
+// vaultNamespaceHeader seleciona o namespace do Vault Enterprise da requisição. O valor é repassado
+// ao vault-gateway nos metadados gRPC.
+const (
+	vaultNamespaceHeader   = "X-Vault-Namespace"
+	vaultNamespaceMetadata = "x-vault-namespace"
+)
+
+// forwardVaultNamespace valida o cabeçalho X-Vault-Namespace e o anexa aos metadados das chamadas
+// feitas ao vault-gateway durante a requisição.
+func (s *Server) forwardVaultNamespace(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(vaultNamespaceHeader) == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		namespace := vaultNamespace(r)
+		if _, valid := normalizeSecretPath(namespace); !valid || strings.HasSuffix(namespace, "/") {
+			s.respondWithError(w, http.StatusBadRequest, "Namespace do Vault inválido", nil)
+			return
+		}
+		ctx := metadata.AppendToOutgoingContext(r.Context(), vaultNamespaceMetadata, namespace)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func vaultNamespace(r *http.Request) string {
+	return strings.Trim(r.Header.Get(vaultNamespaceHeader), "/")
+}
+
+// authorizeSecret extrai o caminho do Vault do curinga da rota e verifica, pelas políticas de segredos, se o chamador
+// tem a capacidade pedida sobre ele. Em caso de negação a resposta (400 ou 403) já é enviada e ok é false.
+func (s *Server) authorizeSecret(w http.ResponseWriter, r *http.Request, capability string) (string, bool) {
+	return s.authorizeSecretPath(w, r, chi.URLParam(r, "*"), capability)
+}
+
+// authorizeSecretPath faz a mesma verificação de authorizeSecret para um caminho já extraído da URL.
+// Com o cabeçalho X-Vault-Namespace, a política é avaliada sobre "<namespace>/<caminho>", como o
+// Vault enxerga o caminho a partir do namespace raiz; o caminho retornado não inclui o namespace.
+func (s *Server) authorizeSecretPath(w http.ResponseWriter, r *http.Request, rawPath, capability string) (string, bool) {
+	vaultPath, valid := normalizeSecretPath(rawPath)
+	if !valid {
+		s.respondWithError(w, http.StatusBadRequest, "Caminho de segredo inválido", nil)
+		return "", false
+	}
+	if !s.secretAllowed(r, vaultPath, capability) {
+		s.respondWithError(w, http.StatusForbidden, secretDeniedMessage(r, vaultPath, capability), nil)
+		return "", false
+	}
+	return vaultPath, true
+}
+
+// secretDeniedMessage registra a negação de acesso a um segredo e retorna a mensagem de erro da resposta.
+func secretDeniedMessage(r *http.Request, vaultPath, capability string) string {
+	return logSecretDenied(auth.FromContext(r.Context()), secretPolicyPath(r, vaultPath), capability)
+}
+
+func logSecretDenied(identity *auth.Identity, policyPath, capability string) string {
+	caller := ""
+	if identity != nil {
+		caller = identity.Name
+	}
+	slog.Warn("Acesso a segredo negado por política", "caller", caller, "capability", capability, "path", policyPath)
+	return "Acesso negado ao caminho '" + policyPath + "'"
+}
+
+// secretAllowed avalia a política para um caminho já normalizado, sem responder à requisição.
+func (s *Server) secretAllowed(r *http.Request, vaultPath, capability string) bool {
+	return s.secretPolicies.Allowed(auth.FromContext(r.Context()), secretPolicyPath(r, vaultPath), capability)
+}
+
+func secretPolicyPath(r *http.Request, vaultPath string) string {
+	return namespacedSecretPath(vaultNamespace(r), vaultPath)
+}
+
+func namespacedSecretPath(namespace, vaultPath string) string {
+	if namespace != "" {
+		return namespace + "/" + vaultPath
+	}
+	return vaultPath
+}
+
+// normalizeSecretPath rejeita caminhos vazios ou com segmentos vazios, "." e "..", para que uma política
+// não possa ser contornada por um caminho que o Vault resolveria em outro lugar. A barra final, usada
+// nas listagens, é preservada.
+func normalizeSecretPath(vaultPath string) (string, bool) {
+	vaultPath = strings.TrimLeft(vaultPath, "/")
+	trimmed := strings.TrimSuffix(vaultPath, "/")
+	if trimmed == "" {
+		return "", false
+	}
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", false
+		}
+	}
+	return vaultPath, true
+}
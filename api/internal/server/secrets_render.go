@@ -0,0 +1,338 @@
+package server
+
+import (
+	"api/internal/auth"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"api/proto/vault"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxRenderTemplateSize = 64 << 10
+	maxRenderOutputSize   = 1 << 20
+	maxRenderSecrets      = 50
+)
+
+// renderTimeout é o prazo para executar um template, incluindo a leitura dos segredos.
+var renderTimeout = 10 * time.Second
+
+var errRenderOutputTooLarge = errors.New("resultado do template excede o tamanho máximo")
+
+// renderDisabledFuncs são as funções embutidas do text/template que montam strings inteiras na
+// memória antes de qualquer escrita, como {{printf "%999999999d" 1}}; o limite da saída não as alcança.
+var renderDisabledFuncs = []string{"print", "printf", "println", "html", "js", "urlquery"}
+
+type renderTemplateRequest struct {
+	Template string `json:"template"`
+}
+
+// renderError é o erro devolvido pela função secret do template; guarda o status HTTP da resposta.
+// A mensagem é fixa: nunca contém caminhos, chaves ou valores de segredos.
+type renderError struct {
+	status  int
+	message string
+	err     error
+}
+
+func (e *renderError) Error() string { return e.message }
+
+// secretRenderer resolve as referências {{ secret "<caminho>" "<chave>" }} de um template com as
+// permissões do chamador, lendo cada segredo uma única vez por requisição.
+type secretRenderer struct {
+	s         *Server
+	ctx       context.Context
+	identity  *auth.Identity
+	namespace string
+	secrets   map[string]map[string]interface{}
+}
+
+// handleRenderSecretTemplate renderiza um text/template do Go em que segredos são referenciados por
+// {{ secret "kv/data/app" "password" }}. Cada caminho passa pela política de segredos com a
+// capacidade read, como numa leitura direta. O template e o resultado nunca são registrados em log,
+// e os erros só descrevem caminhos e chaves, não valores. A execução roda no próprio handler, com
+// prazo de renderTimeout verificado a cada escrita e a cada leitura de segredo.
+func (s *Server) handleRenderSecretTemplate(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRenderTemplateSize+1024)
+	var request renderTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", nil)
+		return
+	}
+	if request.Template == "" {
+		s.respondWithError(w, http.StatusBadRequest, "O campo 'template' é obrigatório", nil)
+		return
+	}
+	if len(request.Template) > maxRenderTemplateSize {
+		s.respondWithError(w, http.StatusRequestEntityTooLarge, "Template excede o tamanho máximo", nil)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), renderTimeout)
+	defer cancel()
+	renderer := &secretRenderer{
+		s:         s,
+		ctx:       ctx,
+		identity:  auth.FromContext(r.Context()),
+		namespace: vaultNamespace(r),
+		secrets:   map[string]map[string]interface{}{},
+	}
+	tmpl, err := template.New("render").
+		Option("missingkey=error").
+		Funcs(renderer.funcs()).
+		Parse(request.Template)
+	if err == nil {
+		err = checkRenderTemplate(tmpl)
+	}
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Template inválido: "+err.Error(), nil)
+		return
+	}
+
+	output := &limitedBuffer{limit: maxRenderOutputSize, ctx: ctx}
+	if err := tmpl.Execute(output, nil); err != nil {
+		var secretErr *renderError
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			s.respondWithError(w, http.StatusGatewayTimeout, "Tempo limite de renderização do template excedido", nil)
+		case errors.As(err, &secretErr):
+			s.respondWithError(w, secretErr.status, secretErr.message, secretErr.err)
+		case errors.Is(err, errRenderOutputTooLarge):
+			s.respondWithError(w, http.StatusRequestEntityTooLarge, "Resultado do template excede o tamanho máximo", nil)
+		default:
+			message, location := describeRenderError(err)
+			slog.Warn("Falha ao executar template", "location", location, "error", message)
+			s.respondWithError(w, http.StatusBadRequest, message, nil)
+		}
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	s.respondWithJSON(w, http.StatusOK, map[string]string{"rendered": output.String()})
+}
+
+// funcs expõe secret e desativa as funções embutidas de renderDisabledFuncs.
+func (sr *secretRenderer) funcs() template.FuncMap {
+	funcs := template.FuncMap{"secret": sr.secret}
+	for _, name := range renderDisabledFuncs {
+		funcs[name] = func(...interface{}) (string, error) {
+			return "", fmt.Errorf("a função %s não está disponível", name)
+		}
+	}
+	return funcs
+}
+
+// secret é a função exposta ao template. Valores que não são strings são renderizados como JSON.
+func (sr *secretRenderer) secret(rawPath, key string) (string, error) {
+	if err := sr.ctx.Err(); err != nil {
+		return "", err
+	}
+	data, err := sr.read(rawPath)
+	if err != nil {
+		return "", err
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", &renderError{status: http.StatusBadRequest, message: "Chave não encontrada em um segredo do template"}
+	}
+	if text, ok := value.(string); ok {
+		return text, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", &renderError{status: http.StatusInternalServerError, message: "Erro interno ao converter valor do segredo"}
+	}
+	return string(encoded), nil
+}
+
+func (sr *secretRenderer) read(rawPath string) (map[string]interface{}, error) {
+	vaultPath, valid := normalizeSecretPath(rawPath)
+	if !valid {
+		return nil, &renderError{status: http.StatusBadRequest, message: "Caminho de segredo inválido no template"}
+	}
+	if data, ok := sr.secrets[vaultPath]; ok {
+		return data, nil
+	}
+	policyPath := namespacedSecretPath(sr.namespace, vaultPath)
+	if !sr.s.secretPolicies.Allowed(sr.identity, policyPath, auth.CapabilityRead) {
+		caller := ""
+		if sr.identity != nil {
+			caller = sr.identity.Name
+		}
+		slog.Warn("Acesso a segredo de template negado por política", "caller", caller, "capability", auth.CapabilityRead)
+		return nil, &renderError{status: http.StatusForbidden, message: "Acesso negado a um segredo do template"}
+	}
+	if len(sr.secrets) >= maxRenderSecrets {
+		return nil, &renderError{status: http.StatusBadRequest, message: fmt.Sprintf("O template referencia mais de %d segredos", maxRenderSecrets)}
+	}
+
+	secret, err := sr.s.gatewayManager.VaultClient.ReadSecret(sr.ctx, &vault.ReadSecretRequest{Path: vaultPath})
+	if err != nil {
+		// A mensagem do gateway pode repetir o caminho; só o código vai para a resposta e o log.
+		code := status.Code(err)
+		return nil, &renderError{
+			status:  httpStatusFromGRPC(err),
+			message: "Erro ao ler um segredo do template no Vault",
+			err:     fmt.Errorf("código %s", code),
+		}
+	}
+	data := secret.GetData().AsMap()
+	// Em KV v2 os valores ficam em "data", ao lado dos metadados da versão.
+	if _, isKVv2 := data["metadata"].(map[string]interface{}); isKVv2 {
+		data, _ = data["data"].(map[string]interface{})
+		if data == nil {
+			return nil, &renderError{status: http.StatusNotFound, message: "Segredo do template não encontrado"}
+		}
+	}
+	sr.secrets[vaultPath] = data
+	return data, nil
+}
+
+// describeRenderError traduz um erro de execução do text/template numa mensagem fixa. O texto do
+// erro termina com os valores envolvidos, como o tamanho de um segredo em "index out of range", e por
+// isso só a parte que vem do template é usada: a posição e o nome da função que falhou.
+func describeRenderError(err error) (message, location string) {
+	message = "Erro ao executar o template"
+	head, tail, found := strings.Cut(err.Error(), ">: ")
+	if !found {
+		return message, ""
+	}
+	location, _, _ = strings.Cut(strings.TrimPrefix(head, "template: "), ": executing")
+	if rest, ok := strings.CutPrefix(tail, "error calling "); ok {
+		if name, _, ok := strings.Cut(rest, ":"); ok && isTemplateIdentifier(name) {
+			message = fmt.Sprintf("Erro ao chamar a função %s no template", name)
+		}
+	}
+	return message + " (" + location + ")", location
+}
+
+func isTemplateIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// limitedBuffer acumula a saída do template e falha ao passar do limite ou depois do prazo, para que
+// um template não possa gerar respostas arbitrariamente grandes nem escrever para sempre.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+	ctx   context.Context
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if err := b.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if b.Len()+len(p) > b.limit {
+		return 0, errRenderOutputTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// checkRenderTemplate recusa {{range}}, {{define}}, {{block}} e {{template}}. Sem eles cada nó
+// executa no máximo uma vez, e o tempo de execução fica limitado pelo tamanho do template, mesmo em
+// trechos que não escrevem nada e por isso não passam pelo limitedBuffer. Recusa também as funções de
+// renderDisabledFuncs e chamadas de secret cujo caminho ou chave não sejam strings literais: um
+// caminho calculado poderia ser o valor de outro segredo, e ele acabaria em mensagens de erro.
+func checkRenderTemplate(tmpl *template.Template) error {
+	if len(tmpl.Templates()) > 1 {
+		return errors.New("{{define}} e {{block}} não são suportados")
+	}
+	if tmpl.Tree == nil {
+		return nil
+	}
+	return checkRenderNode(tmpl.Tree.Root)
+}
+
+func checkRenderNode(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkRenderNode(child); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkRenderPipe(n.Pipe)
+	case *parse.PipeNode:
+		return checkRenderPipe(n)
+	case *parse.IfNode:
+		return checkRenderBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return checkRenderBranch(&n.BranchNode)
+	case *parse.ChainNode:
+		return checkRenderNode(n.Node)
+	case *parse.RangeNode:
+		return errors.New("{{range}} não é suportado")
+	case *parse.TemplateNode:
+		return errors.New("{{template}} não é suportado")
+	case *parse.IdentifierNode:
+		if n.Ident == "secret" {
+			return errSecretArgs
+		}
+		if slices.Contains(renderDisabledFuncs, n.Ident) {
+			return fmt.Errorf("a função %s não está disponível", n.Ident)
+		}
+	}
+	return nil
+}
+
+var errSecretArgs = errors.New(`secret só aceita caminho e chave como strings literais, como em {{ secret "kv/data/app" "password" }}`)
+
+// checkRenderPipe só aceita secret como primeiro comando de um pipeline: nos seguintes, o resultado
+// do comando anterior seria passado como último argumento.
+func checkRenderPipe(pipe *parse.PipeNode) error {
+	if pipe == nil {
+		return nil
+	}
+	for i, cmd := range pipe.Cmds {
+		if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok && ident.Ident == "secret" {
+			if i > 0 || len(cmd.Args) != 3 || !isStringNode(cmd.Args[1]) || !isStringNode(cmd.Args[2]) {
+				return errSecretArgs
+			}
+			continue
+		}
+		for _, arg := range cmd.Args {
+			if err := checkRenderNode(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isStringNode(node parse.Node) bool {
+	_, ok := node.(*parse.StringNode)
+	return ok
+}
+
+func checkRenderBranch(branch *parse.BranchNode) error {
+	if err := checkRenderPipe(branch.Pipe); err != nil {
+		return err
+	}
+	if err := checkRenderNode(branch.List); err != nil {
+		return err
+	}
+	return checkRenderNode(branch.ElseList)
+}
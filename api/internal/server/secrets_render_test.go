@@ -0,0 +1,185 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"api/internal/gateways"
+)
+
+func renderBody(t *testing.T, template string) string {
+	t.Helper()
+	body, err := json.Marshal(renderTemplateRequest{Template: template})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestRenderSecretTemplate(t *testing.T) {
+	vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+		"kv/data/app": {"data": map[string]interface{}{"user": "app", "password": "s3cr3t"}, "metadata": map[string]interface{}{"version": 1}},
+	}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	template := `{{if true}}postgres://{{secret "kv/data/app" "user"}}:{{secret "kv/data/app" "password"}}@db{{else}}none{{end}}`
+	w := doRequest(s, http.MethodPost, "/api/v1/secrets/render", "admin-token", renderBody(t, template))
+	assertStatus(t, w, http.StatusOK)
+	if got := decodeBody(t, w)["rendered"]; got != "postgres://app:s3cr3t@db" {
+		t.Errorf("rendered = %v", got)
+	}
+	if len(vaultClient.reads) != 1 {
+		t.Errorf("reads = %v, want the secret read once", vaultClient.reads)
+	}
+}
+
+func TestRenderSecretTemplateLimits(t *testing.T) {
+	// Cada reatribuição dobraria a variável, sem nenhuma escrita até o fim.
+	var doubling strings.Builder
+	doubling.WriteString(`{{$a := "0123456789abcdef"}}`)
+	for i := 0; i < 40; i++ {
+		doubling.WriteString(`{{$a = printf "%s%s" $a $a}}`)
+	}
+	doubling.WriteString(`{{$a}}`)
+
+	tests := []struct {
+		name       string
+		template   string
+		wantStatus int
+		wantText   string
+	}{
+		{name: "integer range", template: `{{range 1000000000}}{{end}}`, wantText: "{{range}}"},
+		{name: "range in an else branch", template: `{{if true}}a{{else}}{{with 1}}{{range 5}}{{end}}{{end}}{{end}}`, wantText: "{{range}}"},
+		{name: "define", template: `{{define "a"}}x{{end}}`, wantText: "{{define}}"},
+		{name: "block", template: `{{block "a" .}}x{{end}}`, wantText: "{{define}}"},
+		{name: "undefined template", template: `{{if true}}{{template "a"}}{{end}}`, wantText: "{{template}}"},
+		{name: "printf", template: `{{printf "%999999999d" 1}}`, wantText: "printf não está disponível"},
+		{name: "printf doubling a variable", template: doubling.String(), wantText: "printf não está disponível"},
+		{name: "js doubling a variable", template: strings.ReplaceAll(doubling.String(), `printf "%s%s"`, "js"), wantText: "js não está disponível"},
+		// Cada referência escreve de novo o mesmo segredo de 1 KB, lido uma única vez.
+		{name: "output too large", template: strings.Repeat(`{{secret "kv/data/big" "v"}}`, 2000), wantStatus: http.StatusRequestEntityTooLarge, wantText: "tamanho máximo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+				"kv/data/big": {"data": map[string]interface{}{"v": strings.Repeat("x", 1000)}, "metadata": map[string]interface{}{"version": 1}},
+			}}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+			w := doRequest(s, http.MethodPost, "/api/v1/secrets/render", "admin-token", renderBody(t, tt.template))
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusBadRequest
+			}
+			assertStatus(t, w, wantStatus)
+			if !strings.Contains(w.Body.String(), tt.wantText) {
+				t.Errorf("body = %s, want it to contain %q", w.Body.String(), tt.wantText)
+			}
+		})
+	}
+}
+
+// Nenhum caminho, chave ou valor de segredo aparece nas respostas ou nos logs, mesmo quando o template
+// tenta usar um segredo como caminho ou provoca erros de execução que descrevem os dados.
+func TestRenderSecretTemplateDoesNotLeak(t *testing.T) {
+	var logs bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	tests := []struct {
+		name       string
+		template   string
+		wantStatus int
+		wantText   string
+	}{
+		{name: "secret as path", template: `{{secret (secret "kv/data/app" "password") "k"}}`, wantText: "strings literais"},
+		{name: "secret as key", template: `{{secret "kv/data/app" (secret "kv/data/app" "password")}}`, wantText: "strings literais"},
+		{name: "variable as path", template: `{{$p := secret "kv/data/app" "password"}}{{secret $p "k"}}`, wantText: "strings literais"},
+		{name: "piped into secret", template: `{{secret "kv/data/app" "password" | secret "kv/data/app"}}`, wantText: "strings literais"},
+		{name: "secret as a value", template: `{{with secret}}{{end}}`, wantText: "strings literais"},
+		{name: "index out of range", template: `{{index (secret "kv/data/app" "password") 100}}`, wantText: "função index no template (render:1:2)"},
+		{name: "missing key", template: `{{secret "kv/data/app" "s3cr3t-key"}}`, wantText: "Chave não encontrada"},
+		{name: "invalid path", template: `{{secret "kv/../s3cr3t-path" "k"}}`, wantText: "Caminho de segredo inválido"},
+		{name: "denied path", template: `{{secret "other/s3cr3t-path" "k"}}`, wantStatus: http.StatusForbidden, wantText: "Acesso negado"},
+		{name: "vault error", template: `{{secret "kv/data/s3cr3t-path" "k"}}`, wantStatus: http.StatusNotFound, wantText: "Erro ao ler um segredo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.Reset()
+			vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+				"kv/data/app": {"data": map[string]interface{}{"password": "s3cr3t"}, "metadata": map[string]interface{}{"version": 1}},
+			}}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+			w := doRequest(s, http.MethodPost, "/api/v1/secrets/render", "admin-token", renderBody(t, tt.template))
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusBadRequest
+			}
+			assertStatus(t, w, wantStatus)
+			if !strings.Contains(w.Body.String(), tt.wantText) {
+				t.Errorf("body = %s, want it to contain %q", w.Body.String(), tt.wantText)
+			}
+			for _, leaked := range []string{"s3cr3t", "out of range", "100"} {
+				if strings.Contains(w.Body.String(), leaked) || strings.Contains(logs.String(), leaked) {
+					t.Errorf("%q found in the response (%s) or the logs (%s)", leaked, w.Body.String(), logs.String())
+				}
+			}
+		})
+	}
+}
+
+// A rota estática de renderização vence o curinga de /api/v1/secrets só no POST; os demais métodos
+// continuam tratando "render" como um caminho de segredo.
+func TestRenderRoute(t *testing.T) {
+	vaultClient := &stubVaultClient{}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	w := doRequest(s, http.MethodPost, "/api/v1/secrets/render", "reader-token", renderBody(t, `static`))
+	assertStatus(t, w, http.StatusOK)
+	if got := decodeBody(t, w)["rendered"]; got != "static" {
+		t.Errorf("rendered = %v", got)
+	}
+	// "render" fica fora de kv/, então a política nega a leitura.
+	w = doRequest(s, http.MethodGet, "/api/v1/secrets/render", "reader-token", "")
+	assertStatus(t, w, http.StatusForbidden)
+}
+
+func TestRenderSecretTemplateDeadline(t *testing.T) {
+	previous := renderTimeout
+	renderTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { renderTimeout = previous })
+
+	vaultClient := &stubVaultClient{blockReads: true}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	start := time.Now()
+	w := doRequest(s, http.MethodPost, "/api/v1/secrets/render", "admin-token", renderBody(t, `{{secret "kv/data/app" "password"}}`))
+	assertStatus(t, w, http.StatusGatewayTimeout)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("render took %s, want it stopped at the deadline", elapsed)
+	}
+}
+
+// Depois do prazo, secret e as escritas da saída falham sem trabalhar.
+func TestRenderStopsAfterDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	renderer := &secretRenderer{ctx: ctx, secrets: map[string]map[string]interface{}{}}
+
+	if _, err := renderer.secret("kv/data/app", "password"); !errors.Is(err, context.Canceled) {
+		t.Errorf("secret err = %v", err)
+	}
+	output := &limitedBuffer{limit: maxRenderOutputSize, ctx: ctx}
+	if _, err := output.Write([]byte("x")); !errors.Is(err, context.Canceled) {
+		t.Errorf("write err = %v", err)
+	}
+}
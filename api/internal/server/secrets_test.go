@@ -0,0 +1,323 @@
+package server
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"api/internal/gateways"
+	"api/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Segredos cujo último segmento coincide com o nome de uma ação ou visão continuam acessíveis pelo
+// caminho; ações e visões são pedidas pela query.
+func TestSecretRoutesDoNotShadowSecretNames(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantCalls  []string
+	}{
+		{name: "write secret named undelete", method: http.MethodPost, target: "/api/v1/secrets/kv/undelete/app", body: `{"a":"b"}`, wantStatus: http.StatusCreated, wantCalls: []string{"WriteSecret kv/undelete/app"}},
+		{name: "write secret named destroy", method: http.MethodPost, target: "/api/v1/secrets/kv/destroy/app", body: `{"a":"b"}`, wantStatus: http.StatusCreated, wantCalls: []string{"WriteSecret kv/destroy/app"}},
+		{name: "undelete action", method: http.MethodPost, target: "/api/v1/secrets/kv/app?action=undelete", body: `{"versions":[1]}`, wantStatus: http.StatusOK, wantCalls: []string{"UndeleteSecret kv/app"}},
+		{name: "destroy action", method: http.MethodPost, target: "/api/v1/secrets/kv/undelete?action=destroy", body: `{"versions":[1]}`, wantStatus: http.StatusOK, wantCalls: []string{"DestroySecretVersions kv/undelete"}},
+		{name: "unknown action", method: http.MethodPost, target: "/api/v1/secrets/kv/app?action=purge", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "read secret named tree", method: http.MethodGet, target: "/api/v1/secrets/kv/tree/app", wantStatus: http.StatusOK},
+		{name: "tree view", method: http.MethodGet, target: "/api/v1/secrets/kv/tree?tree=true", wantStatus: http.StatusOK, wantCalls: []string{"WalkSecrets kv/tree/"}},
+		{name: "read secret named versions", method: http.MethodGet, target: "/api/v1/secrets/kv/app/versions", wantStatus: http.StatusOK},
+		{name: "versions view", method: http.MethodGet, target: "/api/v1/secrets/kv/app/versions?view=versions", wantStatus: http.StatusOK, wantCalls: []string{"GetSecretMetadata kv/app/versions"}},
+		{name: "versions list on GET reads the secret", method: http.MethodGet, target: "/api/v1/secrets/kv/app/versions?versions=1", wantStatus: http.StatusOK},
+		{name: "unknown view", method: http.MethodGet, target: "/api/v1/secrets/kv/app?view=history", wantStatus: http.StatusBadRequest},
+		{name: "list and tree together", method: http.MethodGet, target: "/api/v1/secrets/kv?list=true&tree=true", wantStatus: http.StatusBadRequest},
+		{name: "tree and versions together", method: http.MethodGet, target: "/api/v1/secrets/kv/app?tree=true&view=versions", wantStatus: http.StatusBadRequest},
+		{name: "disabled flags", method: http.MethodGet, target: "/api/v1/secrets/kv/tree/app?list=false&tree=0", wantStatus: http.StatusOK},
+		{name: "invalid tree flag", method: http.MethodGet, target: "/api/v1/secrets/kv?tree=maybe", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{
+				secrets: map[string]map[string]interface{}{
+					"kv/tree/app":     {"data": map[string]interface{}{"a": "b"}},
+					"kv/app/versions": {"data": map[string]interface{}{"a": "b"}},
+				},
+				walk: []*vault.WalkSecretsEntry{{Path: "kv/tree/app"}},
+			}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+			w := doRequest(s, tt.method, tt.target, "admin-token", tt.body)
+			assertStatus(t, w, tt.wantStatus)
+			if !reflect.DeepEqual(vaultClient.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", vaultClient.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSecretActionsRequireCapability(t *testing.T) {
+	vaultClient := &stubVaultClient{}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	// "reader" só tem secrets:read; com o escopo de escrita, a política ainda nega destroy.
+	cfg := testConfig()
+	cfg.APICentral.APIKeys[1].Scopes = []string{"secrets:write"}
+	writer := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, cfg)
+
+	assertStatus(t, doRequest(s, http.MethodPost, "/api/v1/secrets/kv/app?action=destroy", "reader-token", `{"versions":[1]}`), http.StatusForbidden)
+	assertStatus(t, doRequest(writer, http.MethodPost, "/api/v1/secrets/kv/app?action=destroy", "reader-token", `{"versions":[1]}`), http.StatusForbidden)
+	assertStatus(t, doRequest(writer, http.MethodPost, "/api/v1/secrets/kv/app?action=undelete", "reader-token", `{"versions":[1]}`), http.StatusForbidden)
+	if len(vaultClient.calls) != 0 {
+		t.Errorf("calls = %v, want none", vaultClient.calls)
+	}
+}
+
+// A chave "hidden" é um dado como outro qualquer: segredos marcados pelo antigo soft delete são
+// migrados com o comando migrate-hidden, e a leitura não os esconde mais.
+func TestSecretWithHiddenKeyIsReadable(t *testing.T) {
+	vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+		"kv/app": {"data": map[string]interface{}{"hidden": "true"}, "metadata": map[string]interface{}{"version": 1}},
+	}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	w := doRequest(s, http.MethodGet, "/api/v1/secrets/kv/app", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+	data, _ := decodeBody(t, w)["data"].(map[string]interface{})
+	if data["hidden"] != "true" {
+		t.Errorf("data = %v, want the hidden key", data)
+	}
+}
+
+func TestReadSecretVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		wantStatus  int
+		wantVersion int32
+	}{
+		{name: "latest", target: "/api/v1/secrets/kv/app", wantStatus: http.StatusOK},
+		{name: "specific version", target: "/api/v1/secrets/kv/app?version=3", wantStatus: http.StatusOK, wantVersion: 3},
+		{name: "version zero", target: "/api/v1/secrets/kv/app?version=0", wantStatus: http.StatusBadRequest},
+		{name: "negative version", target: "/api/v1/secrets/kv/app?version=-1", wantStatus: http.StatusBadRequest},
+		{name: "not a number", target: "/api/v1/secrets/kv/app?version=latest", wantStatus: http.StatusBadRequest},
+		{name: "too large", target: "/api/v1/secrets/kv/app?version=4294967296", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+				"kv/app": {"data": map[string]interface{}{"a": "b"}},
+			}}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+			w := doRequest(s, http.MethodGet, tt.target, "reader-token", "")
+			assertStatus(t, w, tt.wantStatus)
+			if tt.wantStatus != http.StatusOK {
+				if len(vaultClient.readRequests) != 0 {
+					t.Errorf("reads = %v, want none", vaultClient.reads)
+				}
+				return
+			}
+			if len(vaultClient.readRequests) != 1 || vaultClient.readRequests[0].GetVersion() != tt.wantVersion {
+				t.Errorf("read requests = %v, want one with version %d", vaultClient.readRequests, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestSecretVersions(t *testing.T) {
+	vaultClient := &stubVaultClient{metadata: map[string]*vault.GetSecretMetadataResponse{
+		"kv/data/app": {
+			CurrentVersion: 2,
+			MaxVersions:    10,
+			CustomMetadata: map[string]string{"owner": "infra"},
+			Versions: []*vault.SecretVersionMetadata{
+				{Version: 1, CreatedTime: "2026-01-01T00:00:00Z", DeletionTime: "2026-01-02T00:00:00Z"},
+				{Version: 2, CreatedTime: "2026-01-03T00:00:00Z"},
+			},
+		},
+	}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	w := doRequest(s, http.MethodGet, "/api/v1/secrets/kv/data/app?view=versions", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+	body := decodeBody(t, w)
+	if body["current_version"] != float64(2) || body["max_versions"] != float64(10) {
+		t.Errorf("body = %v, want current_version 2 and max_versions 10", body)
+	}
+	versions, _ := body["versions"].([]interface{})
+	if len(versions) != 2 {
+		t.Fatalf("versions = %v, want 2 versions", body["versions"])
+	}
+	// Os nomes seguem o .proto, como nas demais respostas do gateway.
+	first, _ := versions[0].(map[string]interface{})
+	if first["created_time"] != "2026-01-01T00:00:00Z" || first["deletion_time"] != "2026-01-02T00:00:00Z" {
+		t.Errorf("versions[0] = %v, want created_time and deletion_time", first)
+	}
+	if custom, _ := body["custom_metadata"].(map[string]interface{}); custom["owner"] != "infra" {
+		t.Errorf("custom_metadata = %v", body["custom_metadata"])
+	}
+
+	w = doRequest(s, http.MethodGet, "/api/v1/secrets/kv/data/missing?view=versions", "reader-token", "")
+	assertStatus(t, w, http.StatusNotFound)
+}
+
+func TestReadSecretETag(t *testing.T) {
+	vaultClient := &stubVaultClient{secrets: map[string]map[string]interface{}{
+		"kv/data/app": {"data": map[string]interface{}{"a": "b"}, "metadata": map[string]interface{}{"version": 7}},
+		"kv/v1app":    {"a": "b"},
+	}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	w := doRequest(s, http.MethodGet, "/api/v1/secrets/kv/data/app", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+	if etag := w.Header().Get("ETag"); etag != `"7"` {
+		t.Errorf("ETag = %q, want %q", etag, `"7"`)
+	}
+
+	// Segredos KV v1 não têm versão, então não há ETag.
+	w = doRequest(s, http.MethodGet, "/api/v1/secrets/kv/v1app", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+	if etag := w.Header().Get("ETag"); etag != "" {
+		t.Errorf("ETag = %q for a KV v1 secret, want none", etag)
+	}
+}
+
+func TestWriteSecretIfMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+		wantCAS    *int32
+	}{
+		{name: "no header", wantStatus: http.StatusCreated},
+		{name: "any version", ifMatch: "*", wantStatus: http.StatusCreated},
+		{name: "version", ifMatch: `"3"`, wantStatus: http.StatusCreated, wantCAS: ptr(int32(3))},
+		{name: "must not exist", ifMatch: `"0"`, wantStatus: http.StatusCreated, wantCAS: ptr(int32(0))},
+		{name: "surrounding spaces", ifMatch: ` "3" `, wantStatus: http.StatusCreated, wantCAS: ptr(int32(3))},
+		{name: "weak tag", ifMatch: `W/"3"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "unquoted", ifMatch: "3", wantStatus: http.StatusPreconditionFailed},
+		{name: "not a version", ifMatch: `"abc"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "negative", ifMatch: `"-1"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "list of tags", ifMatch: `"3", "4"`, wantStatus: http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, method := range []string{http.MethodPut, http.MethodPost} {
+				vaultClient := &stubVaultClient{}
+				s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+				r := newRequest(method, "/api/v1/secrets/kv/data/app", `{"a":"b"}`)
+				r.Header.Set("Authorization", "Bearer admin-token")
+				if tt.ifMatch != "" {
+					r.Header.Set("If-Match", tt.ifMatch)
+				}
+				w := serve(s, r)
+				assertStatus(t, w, tt.wantStatus)
+				if tt.wantStatus != http.StatusCreated {
+					if len(vaultClient.writeRequests) != 0 {
+						t.Errorf("%s: write sent with an invalid If-Match", method)
+					}
+					continue
+				}
+				if len(vaultClient.writeRequests) != 1 {
+					t.Fatalf("%s: writes = %d, want 1", method, len(vaultClient.writeRequests))
+				}
+				if got := vaultClient.writeRequests[0].Cas; !reflect.DeepEqual(got, tt.wantCAS) {
+					t.Errorf("%s: cas = %v, want %v", method, got, tt.wantCAS)
+				}
+				if etag := w.Header().Get("ETag"); etag != `"1"` {
+					t.Errorf("%s: ETag = %q, want the written version", method, etag)
+				}
+			}
+		})
+	}
+}
+
+// Uma falha de CAS só vira 412 quando o cliente pediu a escrita condicional; sem If-Match, o mesmo
+// erro do gateway segue o mapeamento padrão.
+func TestWriteSecretCASMismatch(t *testing.T) {
+	vaultClient := &stubVaultClient{writeErr: status.Error(codes.FailedPrecondition, "check-and-set parameter did not match the current version")}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+
+	r := newRequest(http.MethodPut, "/api/v1/secrets/kv/data/app", `{"a":"b"}`)
+	r.Header.Set("Authorization", "Bearer admin-token")
+	r.Header.Set("If-Match", `"2"`)
+	assertStatus(t, serve(s, r), http.StatusPreconditionFailed)
+
+	assertStatus(t, doRequest(s, http.MethodPut, "/api/v1/secrets/kv/data/app", "admin-token", `{"a":"b"}`), http.StatusConflict)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestPatchSecret(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		ifMatch     string
+		body        string
+		writeErr    error
+		wantStatus  int
+		wantData    map[string]interface{}
+		wantCAS     *int32
+	}{
+		{name: "merge patch", contentType: "application/merge-patch+json", body: `{"user":"app","old":null}`, wantStatus: http.StatusOK, wantData: map[string]interface{}{"user": "app", "old": nil}},
+		{name: "plain JSON", contentType: "application/json; charset=utf-8", body: `{"user":"app"}`, wantStatus: http.StatusOK, wantData: map[string]interface{}{"user": "app"}},
+		{name: "conditional", contentType: "application/merge-patch+json", ifMatch: `"1"`, body: `{"user":"app"}`, wantStatus: http.StatusOK, wantData: map[string]interface{}{"user": "app"}, wantCAS: ptr(int32(1))},
+		{name: "missing secret", path: "kv/data/missing", contentType: "application/merge-patch+json", body: `{"user":"app"}`, wantStatus: http.StatusNotFound, wantData: map[string]interface{}{"user": "app"}},
+		{name: "KV v1 mount", contentType: "application/merge-patch+json", body: `{"user":"app"}`, writeErr: status.Error(codes.FailedPrecondition, "not a KV v2 mount"), wantStatus: http.StatusConflict, wantData: map[string]interface{}{"user": "app"}},
+		{name: "wrong content type", contentType: "text/plain", body: `{"user":"app"}`, wantStatus: http.StatusUnsupportedMediaType},
+		{name: "no content type", body: `{"user":"app"}`, wantStatus: http.StatusUnsupportedMediaType},
+		{name: "invalid JSON", contentType: "application/merge-patch+json", body: `{"user":`, wantStatus: http.StatusBadRequest},
+		{name: "invalid If-Match", contentType: "application/merge-patch+json", ifMatch: `W/"1"`, body: `{"user":"app"}`, wantStatus: http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{
+				secrets:  map[string]map[string]interface{}{"kv/data/app": {"data": map[string]interface{}{"old": "x"}}},
+				writeErr: tt.writeErr,
+			}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, testConfig())
+			path := tt.path
+			if path == "" {
+				path = "kv/data/app"
+			}
+
+			r := newRequest(http.MethodPatch, "/api/v1/secrets/"+path, tt.body)
+			r.Header.Set("Authorization", "Bearer admin-token")
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.ifMatch != "" {
+				r.Header.Set("If-Match", tt.ifMatch)
+			}
+			w := serve(s, r)
+			assertStatus(t, w, tt.wantStatus)
+			if tt.wantData == nil {
+				if len(vaultClient.patchRequests) != 0 {
+					t.Errorf("patch sent to the gateway: %v", vaultClient.patchRequests)
+				}
+				return
+			}
+			if len(vaultClient.patchRequests) != 1 {
+				t.Fatalf("patches = %d, want 1", len(vaultClient.patchRequests))
+			}
+			request := vaultClient.patchRequests[0]
+			if got := request.GetData().AsMap(); !reflect.DeepEqual(got, tt.wantData) {
+				t.Errorf("patch data = %v, want %v", got, tt.wantData)
+			}
+			if !reflect.DeepEqual(request.Cas, tt.wantCAS) {
+				t.Errorf("cas = %v, want %v", request.Cas, tt.wantCAS)
+			}
+			if tt.wantStatus == http.StatusOK && w.Header().Get("ETag") != `"2"` {
+				t.Errorf("ETag = %q, want the patched version", w.Header().Get("ETag"))
+			}
+		})
+	}
+}
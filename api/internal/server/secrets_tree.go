@@ -0,0 +1,234 @@
+package server
+
+import (
+	"api/internal/auth"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"api/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxSecretTreeEntries limita o número de segredos e pastas de uma árvore; o gateway interrompe
+	// o percurso ao passar dele.
+	maxSecretTreeEntries = 10000
+	// maxSecretTreeValueBytes limita o tamanho somado dos valores incluídos com ?values=true.
+	maxSecretTreeValueBytes = 8 << 20
+
+	defaultSecretTreeConcurrency = 4
+	maxSecretTreeConcurrency     = 16
+)
+
+var errSecretTreeTooLarge = errors.New("árvore de segredos grande demais")
+
+// secretTreeResponse é a hierarquia devolvida por GET /api/v1/secrets/*?tree=true. Em Keys, pastas
+// terminam em "/" e trazem suas próprias chaves; segredos valem null ou, com ?values=true, seus dados.
+type secretTreeResponse struct {
+	Path   string                 `json:"path"`
+	Keys   map[string]interface{} `json:"keys"`
+	Errors map[string]string      `json:"errors,omitempty"`
+}
+
+// handleSecretTree lista recursivamente a pasta da URL. A política exige list sobre a pasta raiz;
+// cada entrada só aparece se o chamador puder listar a pasta em que ela está. O gateway devolve só
+// os caminhos: com ?values=true, a API lê depois apenas os segredos que o chamador pode ler, para
+// que valores fora da política nunca saiam do gateway.
+func (s *Server) handleSecretTree(w http.ResponseWriter, r *http.Request) {
+	includeValues := false
+	if value := r.URL.Query().Get("values"); value != "" {
+		var err error
+		if includeValues, err = strconv.ParseBool(value); err != nil {
+			s.respondWithError(w, http.StatusBadRequest, "Parâmetro 'values' inválido", nil)
+			return
+		}
+	}
+	concurrency := int64(defaultSecretTreeConcurrency)
+	if value := r.URL.Query().Get("concurrency"); value != "" {
+		var err error
+		if concurrency, err = strconv.ParseInt(value, 10, 32); err != nil || concurrency < 1 {
+			s.respondWithError(w, http.StatusBadRequest, "Parâmetro 'concurrency' inválido", nil)
+			return
+		}
+	}
+	root, ok := s.authorizeSecret(w, r, auth.CapabilityList)
+	if !ok {
+		return
+	}
+	root = strings.TrimSuffix(root, "/") + "/"
+
+	concurrency = min(concurrency, maxSecretTreeConcurrency)
+
+	grpcRequest := &vault.WalkSecretsRequest{Path: root, Concurrency: int32(concurrency), MaxEntries: maxSecretTreeEntries}
+	stream, err := s.gatewayManager.VaultClient.WalkSecrets(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao percorrer segredos do Vault", err)
+		return
+	}
+	tooManyEntries := fmt.Sprintf("A pasta '%s' tem mais de %d entradas; consulte uma pasta mais específica", root, maxSecretTreeEntries)
+
+	// O gateway devolve caminhos de dados (em KV v2, "<mount>/data/..."), com o mesmo número de
+	// segmentos da raiz pedida, ainda que ela tenha sido informada pelo caminho metadata/.
+	rootDepth := strings.Count(root, "/")
+	response := secretTreeResponse{Path: root, Keys: map[string]interface{}{}}
+	var readable []string
+	relatives := map[string]string{}
+	for entries := 0; ; entries++ {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if status.Code(err) == codes.ResourceExhausted || (err == nil && entries >= maxSecretTreeEntries) {
+			s.respondWithError(w, http.StatusRequestEntityTooLarge, tooManyEntries, nil)
+			return
+		}
+		if err != nil {
+			s.respondWithGRPCError(w, "Erro ao percorrer segredos do Vault", err)
+			return
+		}
+		segments := strings.SplitN(entry.GetPath(), "/", rootDepth+1)
+		if len(segments) <= rootDepth || segments[rootDepth] == "" {
+			continue
+		}
+		relative := segments[rootDepth]
+		if parent := path.Dir(strings.TrimSuffix(relative, "/")); parent != "." && !s.secretAllowed(r, root+parent+"/", auth.CapabilityList) {
+			continue
+		}
+		if entry.GetError() != "" {
+			if response.Errors == nil {
+				response.Errors = map[string]string{}
+			}
+			response.Errors[root+relative] = entry.GetError()
+		}
+		insertSecretTreeKey(response.Keys, relative, nil)
+		if includeValues && entry.GetError() == "" && !strings.HasSuffix(relative, "/") && s.secretAllowed(r, entry.GetPath(), auth.CapabilityRead) {
+			readable = append(readable, entry.GetPath())
+			relatives[entry.GetPath()] = relative
+		}
+	}
+
+	if includeValues {
+		values, readErrors, err := s.readSecretTreeValues(r.Context(), readable, int(concurrency))
+		if errors.Is(err, errSecretTreeTooLarge) {
+			s.respondWithError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Os valores da pasta '%s' excedem %d bytes; consulte uma pasta mais específica", root, maxSecretTreeValueBytes), nil)
+			return
+		}
+		if err != nil {
+			s.respondWithError(w, http.StatusGatewayTimeout, "Leitura dos segredos interrompida", err)
+			return
+		}
+		for vaultPath, value := range values {
+			insertSecretTreeKey(response.Keys, relatives[vaultPath], value)
+		}
+		for vaultPath, message := range readErrors {
+			if response.Errors == nil {
+				response.Errors = map[string]string{}
+			}
+			response.Errors[root+relatives[vaultPath]] = message
+		}
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	s.respondWithJSON(w, http.StatusOK, response)
+}
+
+// readSecretTreeValues lê os segredos já autorizados, no máximo concurrency por vez. Segredos que não
+// puderam ser lidos vão para o mapa de erros, como as pastas que o gateway não conseguiu listar; se a
+// soma dos valores passar de maxSecretTreeValueBytes, as leituras são interrompidas.
+func (s *Server) readSecretTreeValues(ctx context.Context, paths []string, concurrency int) (map[string]interface{}, map[string]string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		total    int
+		tooLarge bool
+	)
+	values := make(map[string]interface{}, len(paths))
+	readErrors := map[string]string{}
+	slots := make(chan struct{}, max(concurrency, 1))
+	for _, vaultPath := range paths {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(vaultPath string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			secret, err := s.gatewayManager.VaultClient.ReadSecret(ctx, &vault.ReadSecretRequest{Path: vaultPath})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				readErrors[vaultPath] = status.Convert(err).Message()
+				return
+			}
+			if total += proto.Size(secret.GetData()); total > maxSecretTreeValueBytes {
+				tooLarge = true
+				cancel()
+				return
+			}
+			if value := secretTreeValue(secret.GetData().AsMap()); value != nil {
+				values[vaultPath] = value
+			}
+		}(vaultPath)
+	}
+	wg.Wait()
+
+	if tooLarge {
+		return nil, nil, errSecretTreeTooLarge
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return values, readErrors, nil
+}
+
+// secretTreeValue devolve os dados da versão atual, sem os metadados do KV v2. Versões apagadas ficam
+// sem valor, como na leitura individual.
+func secretTreeValue(data map[string]interface{}) map[string]interface{} {
+	if _, isKVv2 := data["metadata"].(map[string]interface{}); isKVv2 {
+		data, _ = data["data"].(map[string]interface{})
+	}
+	return data
+}
+
+// insertSecretTreeKey coloca o caminho relativo na árvore, criando as pastas intermediárias.
+func insertSecretTreeKey(tree map[string]interface{}, relative string, value interface{}) {
+	for {
+		folder, rest, found := strings.Cut(relative, "/")
+		if !found || rest == "" {
+			if _, exists := tree[relative]; !exists || value != nil {
+				if strings.HasSuffix(relative, "/") {
+					tree[relative] = map[string]interface{}{}
+				} else {
+					tree[relative] = value
+				}
+			}
+			return
+		}
+		child, ok := tree[folder+"/"].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			tree[folder+"/"] = child
+		}
+		tree, relative = child, rest
+	}
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"api/internal/config"
+	"api/internal/gateways"
+	"api/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// treeConfig dá à chave "reader" list sobre todo "kv/", mas read apenas sob "kv/app/public/".
+func treeConfig() *config.Config {
+	cfg := testConfig()
+	cfg.APICentral.SecretPolicies[0].Identities = []string{"key:admin"}
+	cfg.APICentral.SecretPolicies = append(cfg.APICentral.SecretPolicies, config.SecretPolicyConfig{
+		Name:       "reader",
+		Identities: []string{"key:reader"},
+		Rules: []config.SecretPolicyRule{
+			{Path: "kv/*", Capabilities: []string{"list"}},
+			{Path: "kv/app/public/*", Capabilities: []string{"read"}},
+		},
+	})
+	return cfg
+}
+
+func TestSecretTreeReadsOnlyAllowedValues(t *testing.T) {
+	vaultClient := &stubVaultClient{
+		secrets: map[string]map[string]interface{}{
+			"kv/app/public/a":  {"data": map[string]interface{}{"key": "public"}, "metadata": map[string]interface{}{"version": 1}},
+			"kv/app/private/b": {"data": map[string]interface{}{"key": "private"}, "metadata": map[string]interface{}{"version": 1}},
+		},
+		walk: []*vault.WalkSecretsEntry{
+			{Path: "kv/app/public/a"},
+			{Path: "kv/app/public/gone"},
+			{Path: "kv/app/private/b"},
+			{Path: "kv/app/broken/", Error: "permission denied"},
+		},
+	}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, treeConfig())
+
+	w := doRequest(s, http.MethodGet, "/api/v1/secrets/kv/app?tree=true&values=true", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+
+	if vaultClient.walkRequest.GetIncludeValues() || vaultClient.walkRequest.GetMaxEntries() != maxSecretTreeEntries {
+		t.Errorf("walk request = %v, want paths only with max_entries %d", vaultClient.walkRequest, maxSecretTreeEntries)
+	}
+	reads := append([]string(nil), vaultClient.reads...)
+	sort.Strings(reads)
+	if want := []string{"kv/app/public/a", "kv/app/public/gone"}; !reflect.DeepEqual(reads, want) {
+		t.Errorf("reads = %v, want only the readable %v", reads, want)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q", got)
+	}
+
+	body := decodeBody(t, w)
+	want := map[string]interface{}{
+		"public/":  map[string]interface{}{"a": map[string]interface{}{"key": "public"}, "gone": nil},
+		"private/": map[string]interface{}{"b": nil},
+		"broken/":  map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(body["keys"], want) {
+		t.Errorf("keys = %v, want %v", body["keys"], want)
+	}
+	wantErrors := map[string]interface{}{"kv/app/broken/": "permission denied", "kv/app/public/gone": "secret not found"}
+	if !reflect.DeepEqual(body["errors"], wantErrors) {
+		t.Errorf("errors = %v, want %v", body["errors"], wantErrors)
+	}
+}
+
+func TestSecretTreeWithoutValuesReadsNothing(t *testing.T) {
+	vaultClient := &stubVaultClient{walk: []*vault.WalkSecretsEntry{{Path: "kv/app/public/a"}}}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, treeConfig())
+
+	w := doRequest(s, http.MethodGet, "/api/v1/secrets/kv/app?tree=true", "reader-token", "")
+	assertStatus(t, w, http.StatusOK)
+	if len(vaultClient.reads) != 0 {
+		t.Errorf("reads = %v, want none", vaultClient.reads)
+	}
+}
+
+func TestSecretTreeLimits(t *testing.T) {
+	tooMany := make([]*vault.WalkSecretsEntry, maxSecretTreeEntries+1)
+	for i := range tooMany {
+		tooMany[i] = &vault.WalkSecretsEntry{Path: fmt.Sprintf("kv/app/s%d", i)}
+	}
+	large := strings.Repeat("x", maxSecretTreeValueBytes/2)
+
+	tests := []struct {
+		name        string
+		vaultClient *stubVaultClient
+		target      string
+		wantMessage string
+	}{
+		{
+			name:        "gateway stops the walk",
+			vaultClient: &stubVaultClient{walk: tooMany[:2], walkErr: status.Error(codes.ResourceExhausted, "more than 10000 entries under kv/app/")},
+			target:      "/api/v1/secrets/kv/app?tree=true",
+			wantMessage: "mais de 10000 entradas",
+		},
+		{
+			name:        "gateway ignores the limit",
+			vaultClient: &stubVaultClient{walk: tooMany},
+			target:      "/api/v1/secrets/kv/app?tree=true",
+			wantMessage: "mais de 10000 entradas",
+		},
+		{
+			name: "values too large",
+			vaultClient: &stubVaultClient{
+				secrets: map[string]map[string]interface{}{
+					"kv/app/a": {"value": large},
+					"kv/app/b": {"value": large},
+					"kv/app/c": {"value": large},
+				},
+				walk: []*vault.WalkSecretsEntry{{Path: "kv/app/a"}, {Path: "kv/app/b"}, {Path: "kv/app/c"}},
+			},
+			target:      "/api/v1/secrets/kv/app?tree=true&values=true",
+			wantMessage: "excedem",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, &gateways.Manager{VaultClient: tt.vaultClient}, testConfig())
+
+			w := doRequest(s, http.MethodGet, tt.target, "admin-token", "")
+			assertStatus(t, w, http.StatusRequestEntityTooLarge)
+			if !strings.Contains(w.Body.String(), tt.wantMessage) {
+				t.Errorf("body = %s, want it to contain %q", w.Body.String(), tt.wantMessage)
+			}
+		})
+	}
+}
@@ -1,21 +1,24 @@
 package server
 
 import (
+	"api/internal/auth"
 	"api/internal/config"
 	"api/internal/gateways"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
-	"strings"
 	"time"
 
-	"api/proto/vault"
 	monitoring "api/proto/zabbix"
 
 	"github.com/go-chi/chi/v5"
 	chi_middleware "github.com/go-chi/chi/v5/middleware"
 
-	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 const CacheTTL = 60 * time.Second
@@ -23,12 +26,24 @@ const CacheTTL = 60 * time.Second
 type Server struct {
 	router         *chi.Mux
 	gatewayManager *gateways.Manager
+	authenticator  auth.Authenticator
+	secretPolicies *auth.SecretPolicies
 }
 
-func NewServer(manager *gateways.Manager, cfg *config.Config) *Server {
+func NewServer(manager *gateways.Manager, cfg *config.Config) (*Server, error) {
+	authenticator, err := auth.NewAuthenticator(cfg.APICentral)
+	if err != nil {
+		return nil, err
+	}
+	secretPolicies, err := auth.NewSecretPolicies(cfg.APICentral.SecretPolicies)
+	if err != nil {
+		return nil, err
+	}
 	s := &Server{
 		router:         chi.NewRouter(),
 		gatewayManager: manager,
+		authenticator:  authenticator,
+		secretPolicies: secretPolicies,
 	}
 
 	s.router.Use(chi_middleware.RequestID)
@@ -37,18 +52,52 @@ func NewServer(manager *gateways.Manager, cfg *config.Config) *Server {
 	s.router.Use(chi_middleware.Recoverer)
 	s.router.Get("/health", s.healthCheck)
 
+	// Todas as rotas da API exigem autenticação; apenas /health fica de fora.
+	api := s.router.With(s.requireAuth)
+
 	if s.gatewayManager.VaultClient != nil {
-		s.router.Route("/api/v1/secrets", func(r chi.Router) {
+		vaultRoutes := api.With(s.forwardVaultNamespace)
+		// A renderização é um POST que só lê segredos, então basta o escopo secrets:read.
+		vaultRoutes.With(s.requireReadScope("secrets")).Post("/api/v1/secrets/render", s.handleRenderSecretTemplate)
+		vaultRoutes.Route("/api/v1/secrets", func(r chi.Router) {
+			r.Use(s.requireScope("secrets"))
 			r.Get("/*", s.handleReadOrListSecret)
-			r.Post("/*", s.handleWriteSecret)
+			r.Post("/*", s.handlePostSecret)
 			r.Put("/*", s.handleWriteSecret)
 			r.Patch("/*", s.handlePatchSecret)
-			r.Delete("/*", s.handleSoftDeleteSecret)
+			r.Delete("/*", s.handleDeleteSecret)
+		})
+		vaultRoutes.Route("/api/v1/database", func(r chi.Router) {
+			r.Use(s.requireScope("database"))
+			r.Post("/creds/{role}", s.handleGenerateDatabaseCredentials)
+		})
+		// Leases têm escopo próprio: renovar ou revogar credenciais dinâmicas não deve depender do
+		// escopo de segredos KV, e sim ser concedido junto com o do engine que as emite (ex.: database).
+		vaultRoutes.Route("/api/v1/leases", func(r chi.Router) {
+			r.Use(s.requireScope("leases"))
+			r.Post("/renew", s.handleRenewLease)
+			r.Post("/revoke", s.handleRevokeLease)
+		})
+		vaultRoutes.Route("/api/v1/transit", func(r chi.Router) {
+			r.Use(s.requireScope("transit"))
+			r.Post("/{key}/encrypt", s.handleTransitEncrypt)
+			r.Post("/{key}/decrypt", s.handleTransitDecrypt)
+			r.Post("/{key}/rewrap", s.handleTransitRewrap)
+			r.Post("/{key}/sign", s.handleTransitSign)
+			r.Post("/{key}/verify", s.handleTransitVerify)
+		})
+		vaultRoutes.Route("/api/v1/pki", func(r chi.Router) {
+			r.Use(s.requireScope("pki"))
+			r.Post("/issue/{role}", s.handleIssueCertificate)
+			r.Post("/revoke", s.handleRevokeCertificate)
+			r.Get("/ca", s.handleGetCACertificate)
+			r.Get("/crl", s.handleGetCRL)
 		})
 		slog.Info("Vault routes registered")
 	}
 	if s.gatewayManager.ZabbixClient != nil {
-		s.router.Route("/api/v1/zabbix", func(r chi.Router) {
+		api.Route("/api/v1/zabbix", func(r chi.Router) {
+			r.Use(s.requireScope("zabbix"))
 			r.Get("/hostgroups", s.handleListHostGroups)
 			r.Get("/hosts", s.handleListHosts)
 			r.Get("/items", s.handleListItems)
@@ -56,8 +105,12 @@ func NewServer(manager *gateways.Manager, cfg *config.Config) *Server {
 		})
 		slog.Info("Zabbix routes registered")
 	}
+	if s.gatewayManager.NetboxClient != nil {
+		api.Route("/api/v1/netbox", s.registerNetboxRoutes)
+		slog.Info("NetBox routes registered")
+	}
 
-	return s
+	return s, nil
 }
 
 func (s *Server) Router() http.Handler {
@@ -68,114 +121,6 @@ func (s *Server) healthCheck(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"status":"ok"}`))
 }
-func (s *Server) handleReadOrListSecret(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	vaultPath := strings.TrimPrefix(r.URL.Path, "/api/v1/secrets/")
-
-	if strings.Contains(vaultPath, "/metadata/") {
-		grpcRequest := &vault.ListSecretsRequest{Path: vaultPath}
-
-		response, err := s.gatewayManager.VaultClient.ListSecrets(ctx, grpcRequest)
-		if err != nil {
-			s.respondWithError(w, http.StatusInternalServerError, "Erro ao listar segredos do Vault", err)
-			return
-		}
-		s.respondWithJSON(w, http.StatusOK, response.GetKeys())
-		return
-	}
-
-	grpcRequest := &vault.ReadSecretRequest{Path: vaultPath}
-
-	secret, err := s.gatewayManager.VaultClient.ReadSecret(r.Context(), grpcRequest)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao ler segredo do Vault", err)
-		return
-	}
-	s.respondWithJSON(w, http.StatusOK, secret.GetData().AsMap())
-}
-func (s *Server) handleWriteSecret(w http.ResponseWriter, r *http.Request) {
-	vaultPath := strings.TrimPrefix(r.URL.Path, "/api/v1/secrets/")
-	var payload map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
-		return
-	}
-
-	grpcPayload, err := structpb.NewStruct(map[string]interface{}{"data": payload})
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro interno ao converter payload", err)
-		return
-	}
-	grpcRequest := &vault.WriteSecretRequest{Path: vaultPath, Data: grpcPayload}
-
-	_, err = s.gatewayManager.VaultClient.WriteSecret(r.Context(), grpcRequest)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao escrever segredo no Vault", err)
-		return
-	}
-	s.respondWithJSON(w, http.StatusCreated, map[string]string{"status": "success"})
-}
-func (s *Server) handlePatchSecret(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	vaultPath := strings.TrimPrefix(r.URL.Path, "/api/v1/secrets/")
-
-	readReq := &vault.ReadSecretRequest{Path: vaultPath}
-	existingSecret, err := s.gatewayManager.VaultClient.ReadSecret(ctx, readReq)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao ler segredo para atualização", err)
-		return
-	}
-
-	existingData := existingSecret.GetData().AsMap()["data"].(map[string]interface{})
-
-	var patchData map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&patchData); err != nil {
-		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
-		return
-	}
-
-	for key, value := range patchData {
-		existingData[key] = value
-	}
-
-	grpcPayload, _ := structpb.NewStruct(map[string]interface{}{"data": existingData})
-
-	writeReq := &vault.WriteSecretRequest{Path: vaultPath, Data: grpcPayload}
-	_, err = s.gatewayManager.VaultClient.WriteSecret(ctx, writeReq)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao atualizar segredo no Vault", err)
-		return
-	}
-
-	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
-}
-func (s *Server) handleSoftDeleteSecret(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	vaultPath := strings.TrimPrefix(r.URL.Path, "/api/v1/secrets/")
-
-	readReq := &vault.ReadSecretRequest{Path: vaultPath}
-	existingSecret, err := s.gatewayManager.VaultClient.ReadSecret(ctx, readReq)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao ler segredo para deletar", err)
-		return
-	}
-
-	existingData := existingSecret.GetData().AsMap()["data"].(map[string]interface{})
-
-	existingData["hidden"] = "true"
-
-	grpcPayload, _ := structpb.NewStruct(map[string]interface{}{"data": existingData})
-
-	writeReq := &vault.WriteSecretRequest{Path: vaultPath, Data: grpcPayload}
-	_, err = s.gatewayManager.VaultClient.WriteSecret(ctx, writeReq)
-	if err != nil {
-		s.respondWithError(w, http.StatusInternalServerError, "Erro ao marcar segredo como oculto", err)
-		return
-	}
-
-	s.respondWithJSON(w, http.StatusOK, map[string]string{"status": "success"})
-}
-
 func (s *Server) handleListHostGroups(w http.ResponseWriter, r *http.Request) {
 	grpcRequest := &monitoring.ListHostGroupsRequest{}
 	response, err := s.gatewayManager.ZabbixClient.ListHostGroups(r.Context(), grpcRequest)
@@ -239,9 +184,68 @@ func (s *Server) respondWithJSON(w http.ResponseWriter, code int, payload interf
 	w.WriteHeader(code)
 	w.Write(response)
 }
+
+// respondWithProto serializa uma mensagem de um gateway com protojson, mantendo os nomes de campo do .proto.
+// int64 sai como string e enums pelo nome, conforme o mapeamento JSON do protobuf.
+func (s *Server) respondWithProto(w http.ResponseWriter, code int, message proto.Message) {
+	response, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		slog.Error("Falha ao serializar resposta JSON", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(response)
+}
+
+// decodeProtoBody lê o corpo diretamente em uma mensagem do gateway com protojson, que respeita os nomes
+// JSON do .proto e aceita int64 como número ou string. Campos desconhecidos são recusados.
+func (s *Server) decodeProtoBody(w http.ResponseWriter, r *http.Request, dst proto.Message) bool {
+	body, err := io.ReadAll(r.Body)
+	if err == nil {
+		err = protojson.Unmarshal(body, dst)
+	}
+	if err != nil {
+		s.respondWithError(w, http.StatusBadRequest, "Corpo da requisição JSON inválido", err)
+		return false
+	}
+	return true
+}
+
 func (s *Server) respondWithError(w http.ResponseWriter, code int, message string, internalErr error) {
 	if internalErr != nil {
 		slog.Error(message, "error", internalErr)
 	}
 	s.respondWithJSON(w, code, map[string]string{"error": message})
 }
+
+// httpStatusFromGRPC traduz o código de status retornado por um gateway para o status HTTP equivalente.
+func httpStatusFromGRPC(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondWithGRPCError responde com o status HTTP correspondente ao erro do gateway. Para erros
+// do cliente (4xx) a descrição retornada pelo gateway é anexada à mensagem.
+func (s *Server) respondWithGRPCError(w http.ResponseWriter, message string, err error) {
+	code := httpStatusFromGRPC(err)
+	if code < http.StatusInternalServerError {
+		message = message + ": " + status.Convert(err).Message()
+	}
+	s.respondWithError(w, code, message, err)
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"api/internal/auth"
+	"net/http"
+
+	"api/proto/vault"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// transitPayload aceita um único item nos campos de topo ou vários em batch_input. Assim como na API
+// do Vault, plaintext, input e context são enviados em base64.
+type transitPayload struct {
+	transitItem
+	BatchInput         []transitItem `json:"batch_input"`
+	KeyVersion         int32         `json:"key_version"`
+	HashAlgorithm      string        `json:"hash_algorithm"`
+	SignatureAlgorithm string        `json:"signature_algorithm"`
+	Prehashed          bool          `json:"prehashed"`
+}
+
+type transitItem struct {
+	Plaintext  []byte `json:"plaintext"`
+	Ciphertext string `json:"ciphertext"`
+	Input      []byte `json:"input"`
+	Signature  string `json:"signature"`
+	Context    []byte `json:"context"`
+}
+
+func (p transitPayload) items() []transitItem {
+	if len(p.BatchInput) > 0 {
+		return p.BatchInput
+	}
+	return []transitItem{p.transitItem}
+}
+
+type transitResult struct {
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+	Signature  string `json:"signature,omitempty"`
+	Valid      *bool  `json:"valid,omitempty"`
+	KeyVersion int32  `json:"key_version,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// authorizeTransit verifica a política de segredos sobre "<mount>/<operação>/<chave>" (capacidade
+// write, pois toda operação do transit é um update no Vault) e decodifica o corpo.
+func (s *Server) authorizeTransit(w http.ResponseWriter, r *http.Request, operation string) (string, string, transitPayload, bool) {
+	var payload transitPayload
+	mount := r.URL.Query().Get("mount")
+	if mount == "" {
+		mount = "transit"
+	}
+	key := chi.URLParam(r, "key")
+	if _, ok := s.authorizeSecretPath(w, r, mount+"/"+operation+"/"+key, auth.CapabilityWrite); !ok {
+		return "", "", payload, false
+	}
+	if !s.decodeJSONBody(w, r, &payload) {
+		return "", "", payload, false
+	}
+	return mount, key, payload, true
+}
+
+// respondWithTransitResults responde com o único resultado, ou 400 com o erro dele, quando o pedido
+// não usou batch_input; em lote, os resultados vão em batch_results, cada um com seu próprio erro.
+func (s *Server) respondWithTransitResults(w http.ResponseWriter, payload transitPayload, results []transitResult) {
+	if len(payload.BatchInput) > 0 {
+		s.respondWithJSON(w, http.StatusOK, map[string][]transitResult{"batch_results": results})
+		return
+	}
+	if len(results) != 1 {
+		s.respondWithError(w, http.StatusBadGateway, "Resposta inesperada do Vault", nil)
+		return
+	}
+	if results[0].Error != "" {
+		s.respondWithError(w, http.StatusBadRequest, results[0].Error, nil)
+		return
+	}
+	s.respondWithJSON(w, http.StatusOK, results[0])
+}
+
+func (s *Server) handleTransitEncrypt(w http.ResponseWriter, r *http.Request) {
+	mount, key, payload, ok := s.authorizeTransit(w, r, "encrypt")
+	if !ok {
+		return
+	}
+	grpcRequest := &vault.EncryptRequest{Mount: mount, Key: key, KeyVersion: payload.KeyVersion}
+	for _, item := range payload.items() {
+		grpcRequest.Items = append(grpcRequest.Items, &vault.TransitEncryptItem{Plaintext: item.Plaintext, Context: item.Context})
+	}
+
+	response, err := s.gatewayManager.VaultClient.Encrypt(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao cifrar dados no Vault", err)
+		return
+	}
+	s.respondWithTransitResults(w, payload, ciphertextResults(response.GetResults()))
+}
+
+func (s *Server) handleTransitDecrypt(w http.ResponseWriter, r *http.Request) {
+	mount, key, payload, ok := s.authorizeTransit(w, r, "decrypt")
+	if !ok {
+		return
+	}
+	grpcRequest := &vault.DecryptRequest{Mount: mount, Key: key}
+	for _, item := range payload.items() {
+		grpcRequest.Items = append(grpcRequest.Items, &vault.TransitDecryptItem{Ciphertext: item.Ciphertext, Context: item.Context})
+	}
+
+	response, err := s.gatewayManager.VaultClient.Decrypt(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao decifrar dados no Vault", err)
+		return
+	}
+	results := make([]transitResult, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		results = append(results, transitResult{Plaintext: result.GetPlaintext(), Error: result.GetError()})
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	s.respondWithTransitResults(w, payload, results)
+}
+
+func (s *Server) handleTransitRewrap(w http.ResponseWriter, r *http.Request) {
+	mount, key, payload, ok := s.authorizeTransit(w, r, "rewrap")
+	if !ok {
+		return
+	}
+	grpcRequest := &vault.RewrapRequest{Mount: mount, Key: key, KeyVersion: payload.KeyVersion}
+	for _, item := range payload.items() {
+		grpcRequest.Items = append(grpcRequest.Items, &vault.TransitDecryptItem{Ciphertext: item.Ciphertext, Context: item.Context})
+	}
+
+	response, err := s.gatewayManager.VaultClient.Rewrap(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao recifrar dados no Vault", err)
+		return
+	}
+	s.respondWithTransitResults(w, payload, ciphertextResults(response.GetResults()))
+}
+
+func (s *Server) handleTransitSign(w http.ResponseWriter, r *http.Request) {
+	mount, key, payload, ok := s.authorizeTransit(w, r, "sign")
+	if !ok {
+		return
+	}
+	grpcRequest := &vault.SignRequest{
+		Mount:              mount,
+		Key:                key,
+		KeyVersion:         payload.KeyVersion,
+		HashAlgorithm:      payload.HashAlgorithm,
+		SignatureAlgorithm: payload.SignatureAlgorithm,
+		Prehashed:          payload.Prehashed,
+	}
+	for _, item := range payload.items() {
+		grpcRequest.Items = append(grpcRequest.Items, &vault.TransitSignItem{Input: item.Input, Context: item.Context})
+	}
+
+	response, err := s.gatewayManager.VaultClient.Sign(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao assinar dados no Vault", err)
+		return
+	}
+	results := make([]transitResult, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		results = append(results, transitResult{Signature: result.GetSignature(), KeyVersion: result.GetKeyVersion(), Error: result.GetError()})
+	}
+	s.respondWithTransitResults(w, payload, results)
+}
+
+func (s *Server) handleTransitVerify(w http.ResponseWriter, r *http.Request) {
+	mount, key, payload, ok := s.authorizeTransit(w, r, "verify")
+	if !ok {
+		return
+	}
+	grpcRequest := &vault.VerifyRequest{
+		Mount:              mount,
+		Key:                key,
+		HashAlgorithm:      payload.HashAlgorithm,
+		SignatureAlgorithm: payload.SignatureAlgorithm,
+		Prehashed:          payload.Prehashed,
+	}
+	for _, item := range payload.items() {
+		grpcRequest.Items = append(grpcRequest.Items, &vault.TransitVerifyItem{Input: item.Input, Signature: item.Signature, Context: item.Context})
+	}
+
+	response, err := s.gatewayManager.VaultClient.Verify(r.Context(), grpcRequest)
+	if err != nil {
+		s.respondWithGRPCError(w, "Erro ao verificar assinaturas no Vault", err)
+		return
+	}
+	results := make([]transitResult, 0, len(response.GetResults()))
+	for _, result := range response.GetResults() {
+		item := transitResult{Error: result.GetError()}
+		if item.Error == "" {
+			valid := result.GetValid()
+			item.Valid = &valid
+		}
+		results = append(results, item)
+	}
+	s.respondWithTransitResults(w, payload, results)
+}
+
+func ciphertextResults(grpcResults []*vault.TransitCiphertextResult) []transitResult {
+	results := make([]transitResult, 0, len(grpcResults))
+	for _, result := range grpcResults {
+		results = append(results, transitResult{Ciphertext: result.GetCiphertext(), KeyVersion: result.GetKeyVersion(), Error: result.GetError()})
+	}
+	return results
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"encoding/base64"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"api/internal/config"
+	"api/internal/gateways"
+)
+
+// transitTestConfig permite à chave "admin" usar as chaves do transit montado em "transit/".
+func transitTestConfig() *config.Config {
+	cfg := testConfig()
+	cfg.APICentral.SecretPolicies = append(cfg.APICentral.SecretPolicies, config.SecretPolicyConfig{
+		Name:       "transit",
+		Identities: []string{"key:admin"},
+		Rules:      []config.SecretPolicyRule{{Path: "transit/*", Capabilities: []string{"write"}}},
+	})
+	return cfg
+}
+
+func TestTransitEncryptDecrypt(t *testing.T) {
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name       string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+		wantCalls  []string
+	}{
+		{
+			name:       "single encrypt",
+			target:     "/api/v1/transit/app/encrypt",
+			body:       `{"plaintext":"` + b64("hello") + `"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"ciphertext":"vault:v1:hello","key_version":1}`,
+			wantCalls:  []string{"Encrypt transit/app"},
+		},
+		{
+			name:       "single encrypt item error",
+			target:     "/api/v1/transit/app/encrypt",
+			body:       `{"plaintext":"` + b64("fail") + `"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"encryption failed"}`,
+			wantCalls:  []string{"Encrypt transit/app"},
+		},
+		{
+			name:       "batch encrypt keeps item errors",
+			target:     "/api/v1/transit/app/encrypt?mount=transit",
+			body:       `{"batch_input":[{"plaintext":"` + b64("a") + `"},{"plaintext":"` + b64("fail") + `"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"batch_results":[{"ciphertext":"vault:v1:a","key_version":1},{"error":"encryption failed"}]}`,
+			wantCalls:  []string{"Encrypt transit/app"},
+		},
+		{
+			name:       "single decrypt",
+			target:     "/api/v1/transit/app/decrypt",
+			body:       `{"ciphertext":"vault:v1:hello"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"plaintext":"` + b64("hello") + `"}`,
+			wantCalls:  []string{"Decrypt transit/app"},
+		},
+		{
+			name:       "batch decrypt keeps item errors",
+			target:     "/api/v1/transit/app/decrypt",
+			body:       `{"batch_input":[{"ciphertext":"garbage"},{"ciphertext":"vault:v1:b"}]}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"batch_results":[{"error":"invalid ciphertext"},{"plaintext":"` + b64("b") + `"}]}`,
+			wantCalls:  []string{"Decrypt transit/app"},
+		},
+		{
+			name:       "missing key",
+			target:     "/api/v1/transit/missing/decrypt",
+			body:       `{"ciphertext":"vault:v1:hello"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"error":"Erro ao decifrar dados no Vault: encryption key not found"}`,
+			wantCalls:  []string{"Decrypt transit/missing"},
+		},
+		{
+			name:       "other mount denied by policy",
+			target:     "/api/v1/transit/app/encrypt?mount=transit-prod",
+			body:       `{"plaintext":"` + b64("hello") + `"}`,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid base64 plaintext",
+			target:     "/api/v1/transit/app/encrypt",
+			body:       `{"plaintext":"not base64!"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultClient := &stubVaultClient{}
+			s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, transitTestConfig())
+
+			w := doRequest(s, http.MethodPost, tt.target, "admin-token", tt.body)
+			assertStatus(t, w, tt.wantStatus)
+			if tt.wantBody != "" {
+				assertJSONEqual(t, w.Body.Bytes(), tt.wantBody)
+			}
+			if !reflect.DeepEqual(vaultClient.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", vaultClient.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestTransitEncryptRequest(t *testing.T) {
+	vaultClient := &stubVaultClient{}
+	s := newTestServer(t, &gateways.Manager{VaultClient: vaultClient}, transitTestConfig())
+
+	body := `{"batch_input":[{"plaintext":"YQ==","context":"dGVuYW50"},{"plaintext":"Yg=="}],"key_version":3}`
+	assertStatus(t, doRequest(s, http.MethodPost, "/api/v1/transit/app/encrypt", "admin-token", body), http.StatusOK)
+
+	request := vaultClient.encryptRequest
+	if request.GetMount() != "transit" || request.GetKey() != "app" || request.GetKeyVersion() != 3 {
+		t.Errorf("request = %v, want mount transit, key app and key_version 3", request)
+	}
+	items := request.GetItems()
+	if len(items) != 2 || string(items[0].GetPlaintext()) != "a" || string(items[0].GetContext()) != "tenant" || string(items[1].GetPlaintext()) != "b" {
+		t.Errorf("items = %v, want the decoded batch_input", items)
+	}
+}
+
+func TestTransitDecryptIsNotCached(t *testing.T) {
+	s := newTestServer(t, &gateways.Manager{VaultClient: &stubVaultClient{}}, transitTestConfig())
+
+	w := doRequest(s, http.MethodPost, "/api/v1/transit/app/decrypt", "admin-token", `{"ciphertext":"vault:v1:a"}`)
+	assertStatus(t, w, http.StatusOK)
+	if got := w.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", got)
+	}
+}
@@ -25,6 +25,7 @@ const (
 type ReadSecretRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,15 +67,23 @@ func (x *ReadSecretRequest) GetPath() string {
 	return ""
 }
 
+func (x *ReadSecretRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ReadSecretResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	LeaseId       string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
-	Renewable     bool                   `protobuf:"varint,3,opt,name=renewable,proto3" json:"renewable,omitempty"`
-	LeaseDuration int32                  `protobuf:"varint,4,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
-	Data          *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestId       string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	LeaseId         string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Renewable       bool                   `protobuf:"varint,3,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	LeaseDuration   int32                  `protobuf:"varint,4,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
+	Data            *structpb.Struct       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	VersionMetadata *SecretVersionMetadata `protobuf:"bytes,6,opt,name=version_metadata,json=versionMetadata,proto3" json:"version_metadata,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ReadSecretResponse) Reset() {
@@ -142,17 +151,93 @@ func (x *ReadSecretResponse) GetData() *structpb.Struct {
 	return nil
 }
 
+func (x *ReadSecretResponse) GetVersionMetadata() *SecretVersionMetadata {
+	if x != nil {
+		return x.VersionMetadata
+	}
+	return nil
+}
+
+type SecretVersionMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedTime   string                 `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	DeletionTime  string                 `protobuf:"bytes,3,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	Destroyed     bool                   `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretVersionMetadata) Reset() {
+	*x = SecretVersionMetadata{}
+	mi := &file_proto_vault_vault_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretVersionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretVersionMetadata) ProtoMessage() {}
+
+func (x *SecretVersionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretVersionMetadata.ProtoReflect.Descriptor instead.
+func (*SecretVersionMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SecretVersionMetadata) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretVersionMetadata) GetCreatedTime() string {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return ""
+}
+
+func (x *SecretVersionMetadata) GetDeletionTime() string {
+	if x != nil {
+		return x.DeletionTime
+	}
+	return ""
+}
+
+func (x *SecretVersionMetadata) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
 type WriteSecretRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Cas           *int32                 `protobuf:"varint,3,opt,name=cas,proto3,oneof" json:"cas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WriteSecretRequest) Reset() {
 	*x = WriteSecretRequest{}
-	mi := &file_proto_vault_vault_proto_msgTypes[2]
+	mi := &file_proto_vault_vault_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +249,7 @@ func (x *WriteSecretRequest) String() string {
 func (*WriteSecretRequest) ProtoMessage() {}
 
 func (x *WriteSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vault_vault_proto_msgTypes[2]
+	mi := &file_proto_vault_vault_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +262,7 @@ func (x *WriteSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteSecretRequest.ProtoReflect.Descriptor instead.
 func (*WriteSecretRequest) Descriptor() ([]byte, []int) {
-	return file_proto_vault_vault_proto_rawDescGZIP(), []int{2}
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WriteSecretRequest) GetPath() string {
@@ -194,16 +279,24 @@ func (x *WriteSecretRequest) GetData() *structpb.Struct {
 	return nil
 }
 
+func (x *WriteSecretRequest) GetCas() int32 {
+	if x != nil && x.Cas != nil {
+		return *x.Cas
+	}
+	return 0
+}
+
 type WriteSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WriteSecretResponse) Reset() {
 	*x = WriteSecretResponse{}
-	mi := &file_proto_vault_vault_proto_msgTypes[3]
+	mi := &file_proto_vault_vault_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +308,7 @@ func (x *WriteSecretResponse) String() string {
 func (*WriteSecretResponse) ProtoMessage() {}
 
 func (x *WriteSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vault_vault_proto_msgTypes[3]
+	mi := &file_proto_vault_vault_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +321,7 @@ func (x *WriteSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteSecretResponse.ProtoReflect.Descriptor instead.
 func (*WriteSecretResponse) Descriptor() ([]byte, []int) {
-	return file_proto_vault_vault_proto_rawDescGZIP(), []int{3}
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WriteSecretResponse) GetSuccess() bool {
@@ -238,6 +331,125 @@ func (x *WriteSecretResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *WriteSecretResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PatchSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Cas           *int32                 `protobuf:"varint,3,opt,name=cas,proto3,oneof" json:"cas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSecretRequest) Reset() {
+	*x = PatchSecretRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSecretRequest) ProtoMessage() {}
+
+func (x *PatchSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSecretRequest.ProtoReflect.Descriptor instead.
+func (*PatchSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PatchSecretRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PatchSecretRequest) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PatchSecretRequest) GetCas() int32 {
+	if x != nil && x.Cas != nil {
+		return *x.Cas
+	}
+	return 0
+}
+
+type PatchSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchSecretResponse) Reset() {
+	*x = PatchSecretResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchSecretResponse) ProtoMessage() {}
+
+func (x *PatchSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchSecretResponse.ProtoReflect.Descriptor instead.
+func (*PatchSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PatchSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PatchSecretResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ListSecretsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -247,7 +459,7 @@ type ListSecretsRequest struct {
 
 func (x *ListSecretsRequest) Reset() {
 	*x = ListSecretsRequest{}
-	mi := &file_proto_vault_vault_proto_msgTypes[4]
+	mi := &file_proto_vault_vault_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +471,7 @@ func (x *ListSecretsRequest) String() string {
 func (*ListSecretsRequest) ProtoMessage() {}
 
 func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vault_vault_proto_msgTypes[4]
+	mi := &file_proto_vault_vault_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +484,7 @@ func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
 func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_vault_vault_proto_rawDescGZIP(), []int{4}
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListSecretsRequest) GetPath() string {
@@ -291,7 +503,7 @@ type ListSecretsResponse struct {
 
 func (x *ListSecretsResponse) Reset() {
 	*x = ListSecretsResponse{}
-	mi := &file_proto_vault_vault_proto_msgTypes[5]
+	mi := &file_proto_vault_vault_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +515,7 @@ func (x *ListSecretsResponse) String() string {
 func (*ListSecretsResponse) ProtoMessage() {}
 
 func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_vault_vault_proto_msgTypes[5]
+	mi := &file_proto_vault_vault_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +528,7 @@ func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
 func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_vault_vault_proto_rawDescGZIP(), []int{5}
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListSecretsResponse) GetKeys() []string {
@@ -326,34 +538,2645 @@ func (x *ListSecretsResponse) GetKeys() []string {
 	return nil
 }
 
-var File_proto_vault_vault_proto protoreflect.FileDescriptor
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Versions      []int32                `protobuf:"varint,2,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_vault_vault_proto_rawDesc = "" +
-	"\n" +
-	"\x17proto/vault/vault.proto\x12\fsecret_proto\x1a\x1cgoogle/protobuf/struct.proto\"'\n" +
-	"\x11ReadSecretRequest\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\"\xc0\x01\n" +
-	"\x12ReadSecretResponse\x12\x1d\n" +
-	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
-	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12\x1c\n" +
-	"\trenewable\x18\x03 \x01(\bR\trenewable\x12%\n" +
-	"\x0elease_duration\x18\x04 \x01(\x05R\rleaseDuration\x12+\n" +
-	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\"U\n" +
-	"\x12WriteSecretRequest\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\x12+\n" +
-	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\"/\n" +
-	"\x13WriteSecretResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
-	"\x12ListSecretsRequest\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\")\n" +
-	"\x13ListSecretsResponse\x12\x12\n" +
-	"\x04keys\x18\x01 \x03(\tR\x04keys2\x88\x02\n" +
-	"\rSecretService\x12O\n" +
-	"\n" +
-	"ReadSecret\x12\x1f.secret_proto.ReadSecretRequest\x1a .secret_proto.ReadSecretResponse\x12R\n" +
-	"\vWriteSecret\x12 .secret_proto.WriteSecretRequest\x1a!.secret_proto.WriteSecretResponse\x12R\n" +
-	"\vListSecrets\x12 .secret_proto.ListSecretsRequest\x1a!.secret_proto.ListSecretsResponseB\x1bZ\x19vault-gateway/proto/vaultb\x06proto3"
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSecretRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetVersions() []int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretResponse) Reset() {
+	*x = DeleteSecretResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UndeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Versions      []int32                `protobuf:"varint,2,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteSecretRequest) Reset() {
+	*x = UndeleteSecretRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSecretRequest) ProtoMessage() {}
+
+func (x *UndeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UndeleteSecretRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UndeleteSecretRequest) GetVersions() []int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type UndeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteSecretResponse) Reset() {
+	*x = UndeleteSecretResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteSecretResponse) ProtoMessage() {}
+
+func (x *UndeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UndeleteSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DestroySecretVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Versions      []int32                `protobuf:"varint,2,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroySecretVersionsRequest) Reset() {
+	*x = DestroySecretVersionsRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySecretVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySecretVersionsRequest) ProtoMessage() {}
+
+func (x *DestroySecretVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySecretVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DestroySecretVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DestroySecretVersionsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DestroySecretVersionsRequest) GetVersions() []int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DestroySecretVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroySecretVersionsResponse) Reset() {
+	*x = DestroySecretVersionsResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroySecretVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroySecretVersionsResponse) ProtoMessage() {}
+
+func (x *DestroySecretVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroySecretVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DestroySecretVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DestroySecretVersionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSecretMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretMetadataRequest) Reset() {
+	*x = GetSecretMetadataRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretMetadataRequest) ProtoMessage() {}
+
+func (x *GetSecretMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSecretMetadataRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetSecretMetadataResponse struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	CurrentVersion     int32                    `protobuf:"varint,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	OldestVersion      int32                    `protobuf:"varint,2,opt,name=oldest_version,json=oldestVersion,proto3" json:"oldest_version,omitempty"`
+	MaxVersions        int32                    `protobuf:"varint,3,opt,name=max_versions,json=maxVersions,proto3" json:"max_versions,omitempty"`
+	CreatedTime        string                   `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime        string                   `protobuf:"bytes,5,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	CasRequired        bool                     `protobuf:"varint,6,opt,name=cas_required,json=casRequired,proto3" json:"cas_required,omitempty"`
+	DeleteVersionAfter string                   `protobuf:"bytes,7,opt,name=delete_version_after,json=deleteVersionAfter,proto3" json:"delete_version_after,omitempty"`
+	CustomMetadata     map[string]string        `protobuf:"bytes,8,rep,name=custom_metadata,json=customMetadata,proto3" json:"custom_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Versions           []*SecretVersionMetadata `protobuf:"bytes,9,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSecretMetadataResponse) Reset() {
+	*x = GetSecretMetadataResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretMetadataResponse) ProtoMessage() {}
+
+func (x *GetSecretMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSecretMetadataResponse) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *GetSecretMetadataResponse) GetOldestVersion() int32 {
+	if x != nil {
+		return x.OldestVersion
+	}
+	return 0
+}
+
+func (x *GetSecretMetadataResponse) GetMaxVersions() int32 {
+	if x != nil {
+		return x.MaxVersions
+	}
+	return 0
+}
+
+func (x *GetSecretMetadataResponse) GetCreatedTime() string {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return ""
+}
+
+func (x *GetSecretMetadataResponse) GetUpdatedTime() string {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return ""
+}
+
+func (x *GetSecretMetadataResponse) GetCasRequired() bool {
+	if x != nil {
+		return x.CasRequired
+	}
+	return false
+}
+
+func (x *GetSecretMetadataResponse) GetDeleteVersionAfter() string {
+	if x != nil {
+		return x.DeleteVersionAfter
+	}
+	return ""
+}
+
+func (x *GetSecretMetadataResponse) GetCustomMetadata() map[string]string {
+	if x != nil {
+		return x.CustomMetadata
+	}
+	return nil
+}
+
+func (x *GetSecretMetadataResponse) GetVersions() []*SecretVersionMetadata {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type GenerateDatabaseCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDatabaseCredentialsRequest) Reset() {
+	*x = GenerateDatabaseCredentialsRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDatabaseCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDatabaseCredentialsRequest) ProtoMessage() {}
+
+func (x *GenerateDatabaseCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDatabaseCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDatabaseCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GenerateDatabaseCredentialsRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *GenerateDatabaseCredentialsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type GenerateDatabaseCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	LeaseId       string                 `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Renewable     bool                   `protobuf:"varint,3,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	LeaseDuration int32                  `protobuf:"varint,4,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDatabaseCredentialsResponse) Reset() {
+	*x = GenerateDatabaseCredentialsResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDatabaseCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDatabaseCredentialsResponse) ProtoMessage() {}
+
+func (x *GenerateDatabaseCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDatabaseCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateDatabaseCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetRenewable() bool {
+	if x != nil {
+		return x.Renewable
+	}
+	return false
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetLeaseDuration() int32 {
+	if x != nil {
+		return x.LeaseDuration
+	}
+	return 0
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GenerateDatabaseCredentialsResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RenewLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Increment     int32                  `protobuf:"varint,2,opt,name=increment,proto3" json:"increment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewLeaseRequest) Reset() {
+	*x = RenewLeaseRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseRequest) ProtoMessage() {}
+
+func (x *RenewLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenewLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *RenewLeaseRequest) GetIncrement() int32 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+type RenewLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	Renewable     bool                   `protobuf:"varint,2,opt,name=renewable,proto3" json:"renewable,omitempty"`
+	LeaseDuration int32                  `protobuf:"varint,3,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewLeaseResponse) Reset() {
+	*x = RenewLeaseResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLeaseResponse) ProtoMessage() {}
+
+func (x *RenewLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenewLeaseResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *RenewLeaseResponse) GetRenewable() bool {
+	if x != nil {
+		return x.Renewable
+	}
+	return false
+}
+
+func (x *RenewLeaseResponse) GetLeaseDuration() int32 {
+	if x != nil {
+		return x.LeaseDuration
+	}
+	return 0
+}
+
+type RevokeLeaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaseId       string                 `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseRequest) Reset() {
+	*x = RevokeLeaseRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseRequest) ProtoMessage() {}
+
+func (x *RevokeLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type RevokeLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseResponse) Reset() {
+	*x = RevokeLeaseResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseResponse) ProtoMessage() {}
+
+func (x *RevokeLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeLeaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TransitEncryptItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Context       []byte                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitEncryptItem) Reset() {
+	*x = TransitEncryptItem{}
+	mi := &file_proto_vault_vault_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitEncryptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitEncryptItem) ProtoMessage() {}
+
+func (x *TransitEncryptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitEncryptItem.ProtoReflect.Descriptor instead.
+func (*TransitEncryptItem) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TransitEncryptItem) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *TransitEncryptItem) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type TransitCiphertextResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext    string                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitCiphertextResult) Reset() {
+	*x = TransitCiphertextResult{}
+	mi := &file_proto_vault_vault_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitCiphertextResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitCiphertextResult) ProtoMessage() {}
+
+func (x *TransitCiphertextResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitCiphertextResult.ProtoReflect.Descriptor instead.
+func (*TransitCiphertextResult) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransitCiphertextResult) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitCiphertextResult) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *TransitCiphertextResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EncryptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Items         []*TransitEncryptItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptRequest) Reset() {
+	*x = EncryptRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptRequest) ProtoMessage() {}
+
+func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
+func (*EncryptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EncryptRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetItems() []*TransitEncryptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *EncryptRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type EncryptResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*TransitCiphertextResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptResponse) Reset() {
+	*x = EncryptResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptResponse) ProtoMessage() {}
+
+func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptResponse.ProtoReflect.Descriptor instead.
+func (*EncryptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EncryptResponse) GetResults() []*TransitCiphertextResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransitDecryptItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ciphertext    string                 `protobuf:"bytes,1,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Context       []byte                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitDecryptItem) Reset() {
+	*x = TransitDecryptItem{}
+	mi := &file_proto_vault_vault_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitDecryptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitDecryptItem) ProtoMessage() {}
+
+func (x *TransitDecryptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitDecryptItem.ProtoReflect.Descriptor instead.
+func (*TransitDecryptItem) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransitDecryptItem) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
+}
+
+func (x *TransitDecryptItem) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type TransitPlaintextResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plaintext     []byte                 `protobuf:"bytes,1,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitPlaintextResult) Reset() {
+	*x = TransitPlaintextResult{}
+	mi := &file_proto_vault_vault_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitPlaintextResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitPlaintextResult) ProtoMessage() {}
+
+func (x *TransitPlaintextResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitPlaintextResult.ProtoReflect.Descriptor instead.
+func (*TransitPlaintextResult) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransitPlaintextResult) GetPlaintext() []byte {
+	if x != nil {
+		return x.Plaintext
+	}
+	return nil
+}
+
+func (x *TransitPlaintextResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DecryptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Items         []*TransitDecryptItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecryptRequest) Reset() {
+	*x = DecryptRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptRequest) ProtoMessage() {}
+
+func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
+func (*DecryptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DecryptRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *DecryptRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DecryptRequest) GetItems() []*TransitDecryptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DecryptResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*TransitPlaintextResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecryptResponse) Reset() {
+	*x = DecryptResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptResponse) ProtoMessage() {}
+
+func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptResponse.ProtoReflect.Descriptor instead.
+func (*DecryptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DecryptResponse) GetResults() []*TransitPlaintextResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RewrapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Items         []*TransitDecryptItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapRequest) Reset() {
+	*x = RewrapRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapRequest) ProtoMessage() {}
+
+func (x *RewrapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapRequest.ProtoReflect.Descriptor instead.
+func (*RewrapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RewrapRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *RewrapRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RewrapRequest) GetItems() []*TransitDecryptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RewrapRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+type RewrapResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*TransitCiphertextResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewrapResponse) Reset() {
+	*x = RewrapResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewrapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewrapResponse) ProtoMessage() {}
+
+func (x *RewrapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewrapResponse.ProtoReflect.Descriptor instead.
+func (*RewrapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RewrapResponse) GetResults() []*TransitCiphertextResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransitSignItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         []byte                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Context       []byte                 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitSignItem) Reset() {
+	*x = TransitSignItem{}
+	mi := &file_proto_vault_vault_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitSignItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignItem) ProtoMessage() {}
+
+func (x *TransitSignItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignItem.ProtoReflect.Descriptor instead.
+func (*TransitSignItem) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransitSignItem) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitSignItem) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type TransitSignatureResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     string                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,2,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitSignatureResult) Reset() {
+	*x = TransitSignatureResult{}
+	mi := &file_proto_vault_vault_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitSignatureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitSignatureResult) ProtoMessage() {}
+
+func (x *TransitSignatureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitSignatureResult.ProtoReflect.Descriptor instead.
+func (*TransitSignatureResult) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TransitSignatureResult) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitSignatureResult) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *TransitSignatureResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SignRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Mount              string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Key                string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Items              []*TransitSignItem     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	KeyVersion         int32                  `protobuf:"varint,4,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"`
+	HashAlgorithm      string                 `protobuf:"bytes,5,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	SignatureAlgorithm string                 `protobuf:"bytes,6,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	Prehashed          bool                   `protobuf:"varint,7,opt,name=prehashed,proto3" json:"prehashed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SignRequest) Reset() {
+	*x = SignRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignRequest) ProtoMessage() {}
+
+func (x *SignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SignRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *SignRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SignRequest) GetItems() []*TransitSignItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SignRequest) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *SignRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *SignRequest) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+func (x *SignRequest) GetPrehashed() bool {
+	if x != nil {
+		return x.Prehashed
+	}
+	return false
+}
+
+type SignResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*TransitSignatureResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignResponse) Reset() {
+	*x = SignResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignResponse) ProtoMessage() {}
+
+func (x *SignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SignResponse) GetResults() []*TransitSignatureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransitVerifyItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         []byte                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Context       []byte                 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitVerifyItem) Reset() {
+	*x = TransitVerifyItem{}
+	mi := &file_proto_vault_vault_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitVerifyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyItem) ProtoMessage() {}
+
+func (x *TransitVerifyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyItem.ProtoReflect.Descriptor instead.
+func (*TransitVerifyItem) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TransitVerifyItem) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TransitVerifyItem) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TransitVerifyItem) GetContext() []byte {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type TransitVerifyResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitVerifyResult) Reset() {
+	*x = TransitVerifyResult{}
+	mi := &file_proto_vault_vault_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitVerifyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitVerifyResult) ProtoMessage() {}
+
+func (x *TransitVerifyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitVerifyResult.ProtoReflect.Descriptor instead.
+func (*TransitVerifyResult) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransitVerifyResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TransitVerifyResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type VerifyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Mount              string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Key                string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Items              []*TransitVerifyItem   `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	HashAlgorithm      string                 `protobuf:"bytes,4,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	SignatureAlgorithm string                 `protobuf:"bytes,5,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	Prehashed          bool                   `protobuf:"varint,6,opt,name=prehashed,proto3" json:"prehashed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerifyRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetItems() []*TransitVerifyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetHashAlgorithm() string {
+	if x != nil {
+		return x.HashAlgorithm
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetPrehashed() bool {
+	if x != nil {
+		return x.Prehashed
+	}
+	return false
+}
+
+type VerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*TransitVerifyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyResponse) GetResults() []*TransitVerifyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type IssueCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CommonName    string                 `protobuf:"bytes,3,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	AltNames      []string               `protobuf:"bytes,4,rep,name=alt_names,json=altNames,proto3" json:"alt_names,omitempty"`
+	IpSans        []string               `protobuf:"bytes,5,rep,name=ip_sans,json=ipSans,proto3" json:"ip_sans,omitempty"`
+	UriSans       []string               `protobuf:"bytes,6,rep,name=uri_sans,json=uriSans,proto3" json:"uri_sans,omitempty"`
+	Ttl           string                 `protobuf:"bytes,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCertificateRequest) Reset() {
+	*x = IssueCertificateRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateRequest) ProtoMessage() {}
+
+func (x *IssueCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *IssueCertificateRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetAltNames() []string {
+	if x != nil {
+		return x.AltNames
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetIpSans() []string {
+	if x != nil {
+		return x.IpSans
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetUriSans() []string {
+	if x != nil {
+		return x.UriSans
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type IssueCertificateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Certificate    string                 `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	IssuingCa      string                 `protobuf:"bytes,2,opt,name=issuing_ca,json=issuingCa,proto3" json:"issuing_ca,omitempty"`
+	CaChain        []string               `protobuf:"bytes,3,rep,name=ca_chain,json=caChain,proto3" json:"ca_chain,omitempty"`
+	PrivateKey     string                 `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKeyType string                 `protobuf:"bytes,5,opt,name=private_key_type,json=privateKeyType,proto3" json:"private_key_type,omitempty"`
+	SerialNumber   string                 `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Expiration     int64                  `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IssueCertificateResponse) Reset() {
+	*x = IssueCertificateResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateResponse) ProtoMessage() {}
+
+func (x *IssueCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCertificateResponse.ProtoReflect.Descriptor instead.
+func (*IssueCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *IssueCertificateResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetIssuingCa() string {
+	if x != nil {
+		return x.IssuingCa
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetCaChain() []string {
+	if x != nil {
+		return x.CaChain
+	}
+	return nil
+}
+
+func (x *IssueCertificateResponse) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetPrivateKeyType() string {
+	if x != nil {
+		return x.PrivateKeyType
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+type RevokeCertificateRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Mount        string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	SerialNumber string                 `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Role that issued the certificate. The gateway checks that the role allows every name in the
+	// certificate before revoking it.
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateRequest) Reset() {
+	*x = RevokeCertificateRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RevokeCertificateRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *RevokeCertificateRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *RevokeCertificateRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RevokeCertificateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevocationTime int64                  `protobuf:"varint,1,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateResponse) Reset() {
+	*x = RevokeCertificateResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeCertificateResponse) GetRevocationTime() int64 {
+	if x != nil {
+		return x.RevocationTime
+	}
+	return 0
+}
+
+type GetCACertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCACertificateRequest) Reset() {
+	*x = GetCACertificateRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCACertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCACertificateRequest) ProtoMessage() {}
+
+func (x *GetCACertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCACertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetCACertificateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCACertificateRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+type GetCACertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificate   string                 `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCACertificateResponse) Reset() {
+	*x = GetCACertificateResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCACertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCACertificateResponse) ProtoMessage() {}
+
+func (x *GetCACertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCACertificateResponse.ProtoReflect.Descriptor instead.
+func (*GetCACertificateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCACertificateResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type GetCRLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mount         string                 `protobuf:"bytes,1,opt,name=mount,proto3" json:"mount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCRLRequest) Reset() {
+	*x = GetCRLRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCRLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCRLRequest) ProtoMessage() {}
+
+func (x *GetCRLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCRLRequest.ProtoReflect.Descriptor instead.
+func (*GetCRLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCRLRequest) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+type GetCRLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crl           string                 `protobuf:"bytes,1,opt,name=crl,proto3" json:"crl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCRLResponse) Reset() {
+	*x = GetCRLResponse{}
+	mi := &file_proto_vault_vault_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCRLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCRLResponse) ProtoMessage() {}
+
+func (x *GetCRLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCRLResponse.ProtoReflect.Descriptor instead.
+func (*GetCRLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCRLResponse) GetCrl() string {
+	if x != nil {
+		return x.Crl
+	}
+	return ""
+}
+
+type WalkSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	IncludeValues bool                   `protobuf:"varint,2,opt,name=include_values,json=includeValues,proto3" json:"include_values,omitempty"`
+	Concurrency   int32                  `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	MaxEntries    int32                  `protobuf:"varint,4,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalkSecretsRequest) Reset() {
+	*x = WalkSecretsRequest{}
+	mi := &file_proto_vault_vault_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalkSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkSecretsRequest) ProtoMessage() {}
+
+func (x *WalkSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkSecretsRequest.ProtoReflect.Descriptor instead.
+func (*WalkSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WalkSecretsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WalkSecretsRequest) GetIncludeValues() bool {
+	if x != nil {
+		return x.IncludeValues
+	}
+	return false
+}
+
+func (x *WalkSecretsRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *WalkSecretsRequest) GetMaxEntries() int32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+type WalkSecretsEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalkSecretsEntry) Reset() {
+	*x = WalkSecretsEntry{}
+	mi := &file_proto_vault_vault_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalkSecretsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkSecretsEntry) ProtoMessage() {}
+
+func (x *WalkSecretsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_vault_vault_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkSecretsEntry.ProtoReflect.Descriptor instead.
+func (*WalkSecretsEntry) Descriptor() ([]byte, []int) {
+	return file_proto_vault_vault_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WalkSecretsEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WalkSecretsEntry) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WalkSecretsEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_vault_vault_proto protoreflect.FileDescriptor
+
+const file_proto_vault_vault_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/vault/vault.proto\x12\fsecret_proto\x1a\x1cgoogle/protobuf/struct.proto\"A\n" +
+	"\x11ReadSecretRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\x90\x02\n" +
+	"\x12ReadSecretResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\trenewable\x18\x03 \x01(\bR\trenewable\x12%\n" +
+	"\x0elease_duration\x18\x04 \x01(\x05R\rleaseDuration\x12+\n" +
+	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data\x12N\n" +
+	"\x10version_metadata\x18\x06 \x01(\v2#.secret_proto.SecretVersionMetadataR\x0fversionMetadata\"\x97\x01\n" +
+	"\x15SecretVersionMetadata\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12!\n" +
+	"\fcreated_time\x18\x02 \x01(\tR\vcreatedTime\x12#\n" +
+	"\rdeletion_time\x18\x03 \x01(\tR\fdeletionTime\x12\x1c\n" +
+	"\tdestroyed\x18\x04 \x01(\bR\tdestroyed\"t\n" +
+	"\x12WriteSecretRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x15\n" +
+	"\x03cas\x18\x03 \x01(\x05H\x00R\x03cas\x88\x01\x01B\x06\n" +
+	"\x04_cas\"I\n" +
+	"\x13WriteSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"t\n" +
+	"\x12PatchSecretRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x15\n" +
+	"\x03cas\x18\x03 \x01(\x05H\x00R\x03cas\x88\x01\x01B\x06\n" +
+	"\x04_cas\"I\n" +
+	"\x13PatchSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"(\n" +
+	"\x12ListSecretsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\")\n" +
+	"\x13ListSecretsResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"E\n" +
+	"\x13DeleteSecretRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bversions\x18\x02 \x03(\x05R\bversions\"0\n" +
+	"\x14DeleteSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x15UndeleteSecretRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bversions\x18\x02 \x03(\x05R\bversions\"2\n" +
+	"\x16UndeleteSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x1cDestroySecretVersionsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bversions\x18\x02 \x03(\x05R\bversions\"9\n" +
+	"\x1dDestroySecretVersionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\x18GetSecretMetadataRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\x93\x04\n" +
+	"\x19GetSecretMetadataResponse\x12'\n" +
+	"\x0fcurrent_version\x18\x01 \x01(\x05R\x0ecurrentVersion\x12%\n" +
+	"\x0eoldest_version\x18\x02 \x01(\x05R\roldestVersion\x12!\n" +
+	"\fmax_versions\x18\x03 \x01(\x05R\vmaxVersions\x12!\n" +
+	"\fcreated_time\x18\x04 \x01(\tR\vcreatedTime\x12!\n" +
+	"\fupdated_time\x18\x05 \x01(\tR\vupdatedTime\x12!\n" +
+	"\fcas_required\x18\x06 \x01(\bR\vcasRequired\x120\n" +
+	"\x14delete_version_after\x18\a \x01(\tR\x12deleteVersionAfter\x12d\n" +
+	"\x0fcustom_metadata\x18\b \x03(\v2;.secret_proto.GetSecretMetadataResponse.CustomMetadataEntryR\x0ecustomMetadata\x12?\n" +
+	"\bversions\x18\t \x03(\v2#.secret_proto.SecretVersionMetadataR\bversions\x1aA\n" +
+	"\x13CustomMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\"GenerateDatabaseCredentialsRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\xdc\x01\n" +
+	"#GenerateDatabaseCredentialsResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
+	"\blease_id\x18\x02 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\trenewable\x18\x03 \x01(\bR\trenewable\x12%\n" +
+	"\x0elease_duration\x18\x04 \x01(\x05R\rleaseDuration\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\"L\n" +
+	"\x11RenewLeaseRequest\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\tincrement\x18\x02 \x01(\x05R\tincrement\"t\n" +
+	"\x12RenewLeaseResponse\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x1c\n" +
+	"\trenewable\x18\x02 \x01(\bR\trenewable\x12%\n" +
+	"\x0elease_duration\x18\x03 \x01(\x05R\rleaseDuration\"/\n" +
+	"\x12RevokeLeaseRequest\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\"/\n" +
+	"\x13RevokeLeaseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"L\n" +
+	"\x12TransitEncryptItem\x12\x1c\n" +
+	"\tplaintext\x18\x01 \x01(\fR\tplaintext\x12\x18\n" +
+	"\acontext\x18\x02 \x01(\fR\acontext\"p\n" +
+	"\x17TransitCiphertextResult\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x01 \x01(\tR\n" +
+	"ciphertext\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x91\x01\n" +
+	"\x0eEncryptRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x126\n" +
+	"\x05items\x18\x03 \x03(\v2 .secret_proto.TransitEncryptItemR\x05items\x12\x1f\n" +
+	"\vkey_version\x18\x04 \x01(\x05R\n" +
+	"keyVersion\"R\n" +
+	"\x0fEncryptResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.secret_proto.TransitCiphertextResultR\aresults\"N\n" +
+	"\x12TransitDecryptItem\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x01 \x01(\tR\n" +
+	"ciphertext\x12\x18\n" +
+	"\acontext\x18\x02 \x01(\fR\acontext\"L\n" +
+	"\x16TransitPlaintextResult\x12\x1c\n" +
+	"\tplaintext\x18\x01 \x01(\fR\tplaintext\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"p\n" +
+	"\x0eDecryptRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x126\n" +
+	"\x05items\x18\x03 \x03(\v2 .secret_proto.TransitDecryptItemR\x05items\"Q\n" +
+	"\x0fDecryptResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.secret_proto.TransitPlaintextResultR\aresults\"\x90\x01\n" +
+	"\rRewrapRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x126\n" +
+	"\x05items\x18\x03 \x03(\v2 .secret_proto.TransitDecryptItemR\x05items\x12\x1f\n" +
+	"\vkey_version\x18\x04 \x01(\x05R\n" +
+	"keyVersion\"Q\n" +
+	"\x0eRewrapResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.secret_proto.TransitCiphertextResultR\aresults\"A\n" +
+	"\x0fTransitSignItem\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\fR\x05input\x12\x18\n" +
+	"\acontext\x18\x02 \x01(\fR\acontext\"m\n" +
+	"\x16TransitSignatureResult\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\tR\tsignature\x12\x1f\n" +
+	"\vkey_version\x18\x02 \x01(\x05R\n" +
+	"keyVersion\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x81\x02\n" +
+	"\vSignRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.secret_proto.TransitSignItemR\x05items\x12\x1f\n" +
+	"\vkey_version\x18\x04 \x01(\x05R\n" +
+	"keyVersion\x12%\n" +
+	"\x0ehash_algorithm\x18\x05 \x01(\tR\rhashAlgorithm\x12/\n" +
+	"\x13signature_algorithm\x18\x06 \x01(\tR\x12signatureAlgorithm\x12\x1c\n" +
+	"\tprehashed\x18\a \x01(\bR\tprehashed\"N\n" +
+	"\fSignResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.secret_proto.TransitSignatureResultR\aresults\"a\n" +
+	"\x11TransitVerifyItem\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\fR\x05input\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x18\n" +
+	"\acontext\x18\x03 \x01(\fR\acontext\"A\n" +
+	"\x13TransitVerifyResult\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xe4\x01\n" +
+	"\rVerifyRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x125\n" +
+	"\x05items\x18\x03 \x03(\v2\x1f.secret_proto.TransitVerifyItemR\x05items\x12%\n" +
+	"\x0ehash_algorithm\x18\x04 \x01(\tR\rhashAlgorithm\x12/\n" +
+	"\x13signature_algorithm\x18\x05 \x01(\tR\x12signatureAlgorithm\x12\x1c\n" +
+	"\tprehashed\x18\x06 \x01(\bR\tprehashed\"M\n" +
+	"\x0eVerifyResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.secret_proto.TransitVerifyResultR\aresults\"\xc7\x01\n" +
+	"\x17IssueCertificateRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1f\n" +
+	"\vcommon_name\x18\x03 \x01(\tR\n" +
+	"commonName\x12\x1b\n" +
+	"\talt_names\x18\x04 \x03(\tR\baltNames\x12\x17\n" +
+	"\aip_sans\x18\x05 \x03(\tR\x06ipSans\x12\x19\n" +
+	"\buri_sans\x18\x06 \x03(\tR\auriSans\x12\x10\n" +
+	"\x03ttl\x18\a \x01(\tR\x03ttl\"\x86\x02\n" +
+	"\x18IssueCertificateResponse\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\x12\x1d\n" +
+	"\n" +
+	"issuing_ca\x18\x02 \x01(\tR\tissuingCa\x12\x19\n" +
+	"\bca_chain\x18\x03 \x03(\tR\acaChain\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12(\n" +
+	"\x10private_key_type\x18\x05 \x01(\tR\x0eprivateKeyType\x12#\n" +
+	"\rserial_number\x18\x06 \x01(\tR\fserialNumber\x12\x1e\n" +
+	"\n" +
+	"expiration\x18\a \x01(\x03R\n" +
+	"expiration\"i\n" +
+	"\x18RevokeCertificateRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\x12#\n" +
+	"\rserial_number\x18\x02 \x01(\tR\fserialNumber\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"D\n" +
+	"\x19RevokeCertificateResponse\x12'\n" +
+	"\x0frevocation_time\x18\x01 \x01(\x03R\x0erevocationTime\"/\n" +
+	"\x17GetCACertificateRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\"<\n" +
+	"\x18GetCACertificateResponse\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\"%\n" +
+	"\rGetCRLRequest\x12\x14\n" +
+	"\x05mount\x18\x01 \x01(\tR\x05mount\"\"\n" +
+	"\x0eGetCRLResponse\x12\x10\n" +
+	"\x03crl\x18\x01 \x01(\tR\x03crl\"\x92\x01\n" +
+	"\x12WalkSecretsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12%\n" +
+	"\x0einclude_values\x18\x02 \x01(\bR\rincludeValues\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\x12\x1f\n" +
+	"\vmax_entries\x18\x04 \x01(\x05R\n" +
+	"maxEntries\"i\n" +
+	"\x10WalkSecretsEntry\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error2\xaf\x0e\n" +
+	"\rSecretService\x12O\n" +
+	"\n" +
+	"ReadSecret\x12\x1f.secret_proto.ReadSecretRequest\x1a .secret_proto.ReadSecretResponse\x12R\n" +
+	"\vWriteSecret\x12 .secret_proto.WriteSecretRequest\x1a!.secret_proto.WriteSecretResponse\x12R\n" +
+	"\vPatchSecret\x12 .secret_proto.PatchSecretRequest\x1a!.secret_proto.PatchSecretResponse\x12R\n" +
+	"\vListSecrets\x12 .secret_proto.ListSecretsRequest\x1a!.secret_proto.ListSecretsResponse\x12Q\n" +
+	"\vWalkSecrets\x12 .secret_proto.WalkSecretsRequest\x1a\x1e.secret_proto.WalkSecretsEntry0\x01\x12U\n" +
+	"\fDeleteSecret\x12!.secret_proto.DeleteSecretRequest\x1a\".secret_proto.DeleteSecretResponse\x12[\n" +
+	"\x0eUndeleteSecret\x12#.secret_proto.UndeleteSecretRequest\x1a$.secret_proto.UndeleteSecretResponse\x12p\n" +
+	"\x15DestroySecretVersions\x12*.secret_proto.DestroySecretVersionsRequest\x1a+.secret_proto.DestroySecretVersionsResponse\x12d\n" +
+	"\x11GetSecretMetadata\x12&.secret_proto.GetSecretMetadataRequest\x1a'.secret_proto.GetSecretMetadataResponse\x12\x82\x01\n" +
+	"\x1bGenerateDatabaseCredentials\x120.secret_proto.GenerateDatabaseCredentialsRequest\x1a1.secret_proto.GenerateDatabaseCredentialsResponse\x12O\n" +
+	"\n" +
+	"RenewLease\x12\x1f.secret_proto.RenewLeaseRequest\x1a .secret_proto.RenewLeaseResponse\x12R\n" +
+	"\vRevokeLease\x12 .secret_proto.RevokeLeaseRequest\x1a!.secret_proto.RevokeLeaseResponse\x12F\n" +
+	"\aEncrypt\x12\x1c.secret_proto.EncryptRequest\x1a\x1d.secret_proto.EncryptResponse\x12F\n" +
+	"\aDecrypt\x12\x1c.secret_proto.DecryptRequest\x1a\x1d.secret_proto.DecryptResponse\x12C\n" +
+	"\x06Rewrap\x12\x1b.secret_proto.RewrapRequest\x1a\x1c.secret_proto.RewrapResponse\x12=\n" +
+	"\x04Sign\x12\x19.secret_proto.SignRequest\x1a\x1a.secret_proto.SignResponse\x12C\n" +
+	"\x06Verify\x12\x1b.secret_proto.VerifyRequest\x1a\x1c.secret_proto.VerifyResponse\x12a\n" +
+	"\x10IssueCertificate\x12%.secret_proto.IssueCertificateRequest\x1a&.secret_proto.IssueCertificateResponse\x12d\n" +
+	"\x11RevokeCertificate\x12&.secret_proto.RevokeCertificateRequest\x1a'.secret_proto.RevokeCertificateResponse\x12a\n" +
+	"\x10GetCACertificate\x12%.secret_proto.GetCACertificateRequest\x1a&.secret_proto.GetCACertificateResponse\x12C\n" +
+	"\x06GetCRL\x12\x1b.secret_proto.GetCRLRequest\x1a\x1c.secret_proto.GetCRLResponseB\x1bZ\x19vault-gateway/proto/vaultb\x06proto3"
 
 var (
 	file_proto_vault_vault_proto_rawDescOnce sync.Once
@@ -367,30 +3190,127 @@ func file_proto_vault_vault_proto_rawDescGZIP() []byte {
 	return file_proto_vault_vault_proto_rawDescData
 }
 
-var file_proto_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_proto_vault_vault_proto_goTypes = []any{
-	(*ReadSecretRequest)(nil),   // 0: secret_proto.ReadSecretRequest
-	(*ReadSecretResponse)(nil),  // 1: secret_proto.ReadSecretResponse
-	(*WriteSecretRequest)(nil),  // 2: secret_proto.WriteSecretRequest
-	(*WriteSecretResponse)(nil), // 3: secret_proto.WriteSecretResponse
-	(*ListSecretsRequest)(nil),  // 4: secret_proto.ListSecretsRequest
-	(*ListSecretsResponse)(nil), // 5: secret_proto.ListSecretsResponse
-	(*structpb.Struct)(nil),     // 6: google.protobuf.Struct
+	(*ReadSecretRequest)(nil),                   // 0: secret_proto.ReadSecretRequest
+	(*ReadSecretResponse)(nil),                  // 1: secret_proto.ReadSecretResponse
+	(*SecretVersionMetadata)(nil),               // 2: secret_proto.SecretVersionMetadata
+	(*WriteSecretRequest)(nil),                  // 3: secret_proto.WriteSecretRequest
+	(*WriteSecretResponse)(nil),                 // 4: secret_proto.WriteSecretResponse
+	(*PatchSecretRequest)(nil),                  // 5: secret_proto.PatchSecretRequest
+	(*PatchSecretResponse)(nil),                 // 6: secret_proto.PatchSecretResponse
+	(*ListSecretsRequest)(nil),                  // 7: secret_proto.ListSecretsRequest
+	(*ListSecretsResponse)(nil),                 // 8: secret_proto.ListSecretsResponse
+	(*DeleteSecretRequest)(nil),                 // 9: secret_proto.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),                // 10: secret_proto.DeleteSecretResponse
+	(*UndeleteSecretRequest)(nil),               // 11: secret_proto.UndeleteSecretRequest
+	(*UndeleteSecretResponse)(nil),              // 12: secret_proto.UndeleteSecretResponse
+	(*DestroySecretVersionsRequest)(nil),        // 13: secret_proto.DestroySecretVersionsRequest
+	(*DestroySecretVersionsResponse)(nil),       // 14: secret_proto.DestroySecretVersionsResponse
+	(*GetSecretMetadataRequest)(nil),            // 15: secret_proto.GetSecretMetadataRequest
+	(*GetSecretMetadataResponse)(nil),           // 16: secret_proto.GetSecretMetadataResponse
+	(*GenerateDatabaseCredentialsRequest)(nil),  // 17: secret_proto.GenerateDatabaseCredentialsRequest
+	(*GenerateDatabaseCredentialsResponse)(nil), // 18: secret_proto.GenerateDatabaseCredentialsResponse
+	(*RenewLeaseRequest)(nil),                   // 19: secret_proto.RenewLeaseRequest
+	(*RenewLeaseResponse)(nil),                  // 20: secret_proto.RenewLeaseResponse
+	(*RevokeLeaseRequest)(nil),                  // 21: secret_proto.RevokeLeaseRequest
+	(*RevokeLeaseResponse)(nil),                 // 22: secret_proto.RevokeLeaseResponse
+	(*TransitEncryptItem)(nil),                  // 23: secret_proto.TransitEncryptItem
+	(*TransitCiphertextResult)(nil),             // 24: secret_proto.TransitCiphertextResult
+	(*EncryptRequest)(nil),                      // 25: secret_proto.EncryptRequest
+	(*EncryptResponse)(nil),                     // 26: secret_proto.EncryptResponse
+	(*TransitDecryptItem)(nil),                  // 27: secret_proto.TransitDecryptItem
+	(*TransitPlaintextResult)(nil),              // 28: secret_proto.TransitPlaintextResult
+	(*DecryptRequest)(nil),                      // 29: secret_proto.DecryptRequest
+	(*DecryptResponse)(nil),                     // 30: secret_proto.DecryptResponse
+	(*RewrapRequest)(nil),                       // 31: secret_proto.RewrapRequest
+	(*RewrapResponse)(nil),                      // 32: secret_proto.RewrapResponse
+	(*TransitSignItem)(nil),                     // 33: secret_proto.TransitSignItem
+	(*TransitSignatureResult)(nil),              // 34: secret_proto.TransitSignatureResult
+	(*SignRequest)(nil),                         // 35: secret_proto.SignRequest
+	(*SignResponse)(nil),                        // 36: secret_proto.SignResponse
+	(*TransitVerifyItem)(nil),                   // 37: secret_proto.TransitVerifyItem
+	(*TransitVerifyResult)(nil),                 // 38: secret_proto.TransitVerifyResult
+	(*VerifyRequest)(nil),                       // 39: secret_proto.VerifyRequest
+	(*VerifyResponse)(nil),                      // 40: secret_proto.VerifyResponse
+	(*IssueCertificateRequest)(nil),             // 41: secret_proto.IssueCertificateRequest
+	(*IssueCertificateResponse)(nil),            // 42: secret_proto.IssueCertificateResponse
+	(*RevokeCertificateRequest)(nil),            // 43: secret_proto.RevokeCertificateRequest
+	(*RevokeCertificateResponse)(nil),           // 44: secret_proto.RevokeCertificateResponse
+	(*GetCACertificateRequest)(nil),             // 45: secret_proto.GetCACertificateRequest
+	(*GetCACertificateResponse)(nil),            // 46: secret_proto.GetCACertificateResponse
+	(*GetCRLRequest)(nil),                       // 47: secret_proto.GetCRLRequest
+	(*GetCRLResponse)(nil),                      // 48: secret_proto.GetCRLResponse
+	(*WalkSecretsRequest)(nil),                  // 49: secret_proto.WalkSecretsRequest
+	(*WalkSecretsEntry)(nil),                    // 50: secret_proto.WalkSecretsEntry
+	nil,                                         // 51: secret_proto.GetSecretMetadataResponse.CustomMetadataEntry
+	(*structpb.Struct)(nil),                     // 52: google.protobuf.Struct
 }
 var file_proto_vault_vault_proto_depIdxs = []int32{
-	6, // 0: secret_proto.ReadSecretResponse.data:type_name -> google.protobuf.Struct
-	6, // 1: secret_proto.WriteSecretRequest.data:type_name -> google.protobuf.Struct
-	0, // 2: secret_proto.SecretService.ReadSecret:input_type -> secret_proto.ReadSecretRequest
-	2, // 3: secret_proto.SecretService.WriteSecret:input_type -> secret_proto.WriteSecretRequest
-	4, // 4: secret_proto.SecretService.ListSecrets:input_type -> secret_proto.ListSecretsRequest
-	1, // 5: secret_proto.SecretService.ReadSecret:output_type -> secret_proto.ReadSecretResponse
-	3, // 6: secret_proto.SecretService.WriteSecret:output_type -> secret_proto.WriteSecretResponse
-	5, // 7: secret_proto.SecretService.ListSecrets:output_type -> secret_proto.ListSecretsResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	52, // 0: secret_proto.ReadSecretResponse.data:type_name -> google.protobuf.Struct
+	2,  // 1: secret_proto.ReadSecretResponse.version_metadata:type_name -> secret_proto.SecretVersionMetadata
+	52, // 2: secret_proto.WriteSecretRequest.data:type_name -> google.protobuf.Struct
+	52, // 3: secret_proto.PatchSecretRequest.data:type_name -> google.protobuf.Struct
+	51, // 4: secret_proto.GetSecretMetadataResponse.custom_metadata:type_name -> secret_proto.GetSecretMetadataResponse.CustomMetadataEntry
+	2,  // 5: secret_proto.GetSecretMetadataResponse.versions:type_name -> secret_proto.SecretVersionMetadata
+	23, // 6: secret_proto.EncryptRequest.items:type_name -> secret_proto.TransitEncryptItem
+	24, // 7: secret_proto.EncryptResponse.results:type_name -> secret_proto.TransitCiphertextResult
+	27, // 8: secret_proto.DecryptRequest.items:type_name -> secret_proto.TransitDecryptItem
+	28, // 9: secret_proto.DecryptResponse.results:type_name -> secret_proto.TransitPlaintextResult
+	27, // 10: secret_proto.RewrapRequest.items:type_name -> secret_proto.TransitDecryptItem
+	24, // 11: secret_proto.RewrapResponse.results:type_name -> secret_proto.TransitCiphertextResult
+	33, // 12: secret_proto.SignRequest.items:type_name -> secret_proto.TransitSignItem
+	34, // 13: secret_proto.SignResponse.results:type_name -> secret_proto.TransitSignatureResult
+	37, // 14: secret_proto.VerifyRequest.items:type_name -> secret_proto.TransitVerifyItem
+	38, // 15: secret_proto.VerifyResponse.results:type_name -> secret_proto.TransitVerifyResult
+	52, // 16: secret_proto.WalkSecretsEntry.data:type_name -> google.protobuf.Struct
+	0,  // 17: secret_proto.SecretService.ReadSecret:input_type -> secret_proto.ReadSecretRequest
+	3,  // 18: secret_proto.SecretService.WriteSecret:input_type -> secret_proto.WriteSecretRequest
+	5,  // 19: secret_proto.SecretService.PatchSecret:input_type -> secret_proto.PatchSecretRequest
+	7,  // 20: secret_proto.SecretService.ListSecrets:input_type -> secret_proto.ListSecretsRequest
+	49, // 21: secret_proto.SecretService.WalkSecrets:input_type -> secret_proto.WalkSecretsRequest
+	9,  // 22: secret_proto.SecretService.DeleteSecret:input_type -> secret_proto.DeleteSecretRequest
+	11, // 23: secret_proto.SecretService.UndeleteSecret:input_type -> secret_proto.UndeleteSecretRequest
+	13, // 24: secret_proto.SecretService.DestroySecretVersions:input_type -> secret_proto.DestroySecretVersionsRequest
+	15, // 25: secret_proto.SecretService.GetSecretMetadata:input_type -> secret_proto.GetSecretMetadataRequest
+	17, // 26: secret_proto.SecretService.GenerateDatabaseCredentials:input_type -> secret_proto.GenerateDatabaseCredentialsRequest
+	19, // 27: secret_proto.SecretService.RenewLease:input_type -> secret_proto.RenewLeaseRequest
+	21, // 28: secret_proto.SecretService.RevokeLease:input_type -> secret_proto.RevokeLeaseRequest
+	25, // 29: secret_proto.SecretService.Encrypt:input_type -> secret_proto.EncryptRequest
+	29, // 30: secret_proto.SecretService.Decrypt:input_type -> secret_proto.DecryptRequest
+	31, // 31: secret_proto.SecretService.Rewrap:input_type -> secret_proto.RewrapRequest
+	35, // 32: secret_proto.SecretService.Sign:input_type -> secret_proto.SignRequest
+	39, // 33: secret_proto.SecretService.Verify:input_type -> secret_proto.VerifyRequest
+	41, // 34: secret_proto.SecretService.IssueCertificate:input_type -> secret_proto.IssueCertificateRequest
+	43, // 35: secret_proto.SecretService.RevokeCertificate:input_type -> secret_proto.RevokeCertificateRequest
+	45, // 36: secret_proto.SecretService.GetCACertificate:input_type -> secret_proto.GetCACertificateRequest
+	47, // 37: secret_proto.SecretService.GetCRL:input_type -> secret_proto.GetCRLRequest
+	1,  // 38: secret_proto.SecretService.ReadSecret:output_type -> secret_proto.ReadSecretResponse
+	4,  // 39: secret_proto.SecretService.WriteSecret:output_type -> secret_proto.WriteSecretResponse
+	6,  // 40: secret_proto.SecretService.PatchSecret:output_type -> secret_proto.PatchSecretResponse
+	8,  // 41: secret_proto.SecretService.ListSecrets:output_type -> secret_proto.ListSecretsResponse
+	50, // 42: secret_proto.SecretService.WalkSecrets:output_type -> secret_proto.WalkSecretsEntry
+	10, // 43: secret_proto.SecretService.DeleteSecret:output_type -> secret_proto.DeleteSecretResponse
+	12, // 44: secret_proto.SecretService.UndeleteSecret:output_type -> secret_proto.UndeleteSecretResponse
+	14, // 45: secret_proto.SecretService.DestroySecretVersions:output_type -> secret_proto.DestroySecretVersionsResponse
+	16, // 46: secret_proto.SecretService.GetSecretMetadata:output_type -> secret_proto.GetSecretMetadataResponse
+	18, // 47: secret_proto.SecretService.GenerateDatabaseCredentials:output_type -> secret_proto.GenerateDatabaseCredentialsResponse
+	20, // 48: secret_proto.SecretService.RenewLease:output_type -> secret_proto.RenewLeaseResponse
+	22, // 49: secret_proto.SecretService.RevokeLease:output_type -> secret_proto.RevokeLeaseResponse
+	26, // 50: secret_proto.SecretService.Encrypt:output_type -> secret_proto.EncryptResponse
+	30, // 51: secret_proto.SecretService.Decrypt:output_type -> secret_proto.DecryptResponse
+	32, // 52: secret_proto.SecretService.Rewrap:output_type -> secret_proto.RewrapResponse
+	36, // 53: secret_proto.SecretService.Sign:output_type -> secret_proto.SignResponse
+	40, // 54: secret_proto.SecretService.Verify:output_type -> secret_proto.VerifyResponse
+	42, // 55: secret_proto.SecretService.IssueCertificate:output_type -> secret_proto.IssueCertificateResponse
+	44, // 56: secret_proto.SecretService.RevokeCertificate:output_type -> secret_proto.RevokeCertificateResponse
+	46, // 57: secret_proto.SecretService.GetCACertificate:output_type -> secret_proto.GetCACertificateResponse
+	48, // 58: secret_proto.SecretService.GetCRL:output_type -> secret_proto.GetCRLResponse
+	38, // [38:59] is the sub-list for method output_type
+	17, // [17:38] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_vault_vault_proto_init() }
@@ -398,13 +3318,15 @@ func file_proto_vault_vault_proto_init() {
 	if File_proto_vault_vault_proto != nil {
 		return
 	}
+	file_proto_vault_vault_proto_msgTypes[3].OneofWrappers = []any{}
+	file_proto_vault_vault_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_vault_vault_proto_rawDesc), len(file_proto_vault_vault_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
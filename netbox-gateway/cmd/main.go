@@ -8,7 +8,6 @@ import (
 	"os/signal"
 	"syscall"
 
-	"netbox-gateway/internal/auth"
 	"netbox-gateway/internal/config"
 	"netbox-gateway/internal/grpcserver"
 	"netbox-gateway/internal/netbox_client"
@@ -16,8 +15,13 @@ import (
 	"netbox-gateway/proto/ipam"
 	"netbox-gateway/proto/organization"
 	"netbox-gateway/proto/virtualization"
+	"shared/gatewayauth"
+	"shared/tlsconfig"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -46,8 +50,28 @@ func main() {
 		slog.Error("Failed to listen on port 5555", "error", err)
 		os.Exit(1)
 	}
-	authInterceptor := auth.NewAuthInterceptor(cfg.GatewayAuthToken)
-	gServer := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor.Unary()))
+	authTokens, err := gatewayauth.NewTokenSet(cfg.GatewayAuthToken, gatewayauth.ParseMethodPatterns(cfg.GatewayAuthMethods), cfg.GatewayAuthFile)
+	if err != nil {
+		slog.Error("Failed to load internal auth tokens", "error", err)
+		os.Exit(1)
+	}
+	go authTokens.Watch(appCtx, gatewayauth.TokenReloadInterval)
+	authInterceptor := gatewayauth.NewAuthInterceptor(authTokens)
+	serverOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.StreamInterceptor(authInterceptor.Stream()),
+	}
+	if cfg.TLSEnabled {
+		tlsReloader, err := tlsconfig.NewReloader(cfg.TLSCAFile, cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			slog.Error("Failed to load TLS certificates", "error", err)
+			os.Exit(1)
+		}
+		go tlsReloader.Watch(appCtx, tlsconfig.ReloadInterval)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsReloader.ServerConfig())))
+		slog.Info("mTLS enabled for gRPC server")
+	}
+	gServer := grpc.NewServer(serverOpts...)
 
 	server := grpcserver.NewServer(netboxClient)
 
@@ -57,6 +81,10 @@ func main() {
 	ipam.RegisterIpamServiceServer(gServer, server)
 	virtualization.RegisterVirtualizationServiceServer(gServer, server)
 
+	// The gRPC health service is served without a token for orchestrator probes, as in the other gateways.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(gServer, healthServer)
+
 	go func() {
 		slog.Info("Starting NetBox Gateway gRPC server", "port", ":5555")
 		if err := gServer.Serve(lis); err != nil {
@@ -67,6 +95,7 @@ func main() {
 
 	<-appCtx.Done()
 	slog.Info("Shutting down NetBox Gateway...")
+	healthServer.Shutdown()
 	gServer.GracefulStop()
 	slog.Info("NetBox Gateway stopped gracefully")
 }
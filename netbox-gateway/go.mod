@@ -21,4 +21,7 @@ require (
 	golang.org/x/text v0.25.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250528174236-200df99c418a // indirect
 	gopkg.in/validator.v2 v2.0.1 // indirect
+	shared v0.0.0
 )
+
+replace shared => ../shared
@@ -3,19 +3,28 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 )
 
 type Config struct {
-	NetboxAPIURL     string
-	NetboxAPIToken   string
-	GatewayAuthToken string
+	NetboxAPIURL       string
+	NetboxAPIToken     string
+	GatewayAuthToken   string
+	GatewayAuthFile    string
+	GatewayAuthMethods string
+	TLSEnabled         bool
+	TLSCAFile          string
+	TLSCertFile        string
+	TLSKeyFile         string
 }
 
 func LoadConfig() (*Config, error) {
 	cfg := &Config{
-		NetboxAPIURL:     os.Getenv("NETBOX_API_URL"),
-		NetboxAPIToken:   os.Getenv("NETBOX_API_TOKEN"),
-		GatewayAuthToken: os.Getenv("INTERNAL_API_AUTH_TOKEN"),
+		NetboxAPIURL:       os.Getenv("NETBOX_API_URL"),
+		NetboxAPIToken:     os.Getenv("NETBOX_API_TOKEN"),
+		GatewayAuthToken:   os.Getenv("INTERNAL_API_AUTH_TOKEN"),
+		GatewayAuthFile:    os.Getenv("INTERNAL_API_AUTH_TOKENS_FILE"),
+		GatewayAuthMethods: os.Getenv("INTERNAL_API_AUTH_METHODS"),
 	}
 
 	if cfg.NetboxAPIURL == "" {
@@ -24,8 +33,18 @@ func LoadConfig() (*Config, error) {
 	if cfg.NetboxAPIToken == "" {
 		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: NETBOX_API_TOKEN")
 	}
-	if cfg.GatewayAuthToken == "" {
-		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: GATEWAY_AUTH_TOKEN")
+	if cfg.GatewayAuthToken == "" && cfg.GatewayAuthFile == "" {
+		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: INTERNAL_API_AUTH_TOKEN ou INTERNAL_API_AUTH_TOKENS_FILE")
+	}
+
+	cfg.TLSEnabled, _ = strconv.ParseBool(os.Getenv("GRPC_TLS_ENABLED"))
+	if cfg.TLSEnabled {
+		cfg.TLSCAFile = os.Getenv("GRPC_TLS_CA_FILE")
+		cfg.TLSCertFile = os.Getenv("GRPC_TLS_CERT_FILE")
+		cfg.TLSKeyFile = os.Getenv("GRPC_TLS_KEY_FILE")
+		if cfg.TLSCAFile == "" || cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, fmt.Errorf("variáveis de ambiente obrigatórias com GRPC_TLS_ENABLED: GRPC_TLS_CA_FILE, GRPC_TLS_CERT_FILE e GRPC_TLS_KEY_FILE")
+		}
 	}
 
 	return cfg, nil
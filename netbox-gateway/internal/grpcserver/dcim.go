@@ -0,0 +1,311 @@
+package grpcserver
+
+import (
+	"context"
+
+	"netbox-gateway/proto/dcim_proto"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func rackToProto(r *netbox.Rack) *dcim_proto.Rack {
+	rack := &dcim_proto.Rack{
+		Id:       int64(r.GetId()),
+		Name:     r.GetName(),
+		SiteId:   int64(r.Site.GetId()),
+		TenantId: getTenantID(r.Tenant),
+		UHeight:  r.GetUHeight(),
+		Comments: getStringValue(r.Comments),
+	}
+	if r.Status != nil && r.Status.Value != nil {
+		rack.Status = string(*r.Status.Value)
+	}
+	return rack
+}
+
+func deviceToProto(d *netbox.DeviceWithConfigContext) *dcim_proto.Device {
+	device := &dcim_proto.Device{
+		Id:           int64(d.GetId()),
+		Name:         d.GetName(),
+		DeviceTypeId: int64(d.DeviceType.GetId()),
+		RoleId:       int64(d.Role.GetId()),
+		SiteId:       int64(d.Site.GetId()),
+	}
+	if rack := d.Rack.Get(); rack != nil {
+		device.RackId = int64(rack.GetId())
+	}
+	if d.Status != nil && d.Status.Value != nil {
+		device.Status = string(*d.Status.Value)
+	}
+	return device
+}
+
+func rackStatus(value string) (*netbox.PatchedWritableRackRequestStatus, error) {
+	st, err := netbox.NewPatchedWritableRackRequestStatusFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func deviceStatus(value string) (*netbox.DeviceStatusValue, error) {
+	st, err := netbox.NewDeviceStatusValueFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func (s *Server) ListRacks(ctx context.Context, req *dcim_proto.ListRequest) (*dcim_proto.ListRacksResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.DcimAPI.DcimRacksList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list racks")
+	}
+
+	results := make([]*dcim_proto.Rack, len(list.Results))
+	for i := range list.Results {
+		results[i] = rackToProto(&list.Results[i])
+	}
+	return &dcim_proto.ListRacksResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetRack(ctx context.Context, req *dcim_proto.GetRequest) (*dcim_proto.Rack, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	rack, httpResp, err := s.netboxClient.DcimAPI.DcimRacksRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get rack")
+	}
+	return rackToProto(rack), nil
+}
+
+func (s *Server) CreateRack(ctx context.Context, req *dcim_proto.CreateRackRequest) (*dcim_proto.Rack, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	siteID, err := toNetboxID(req.GetSiteId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "site_id is required")
+	}
+
+	body := netbox.NewWritableRackRequest(req.GetName(), netbox.Int32AsDeviceWithConfigContextRequestSite(&siteID))
+	if req.GetStatus() != "" {
+		st, err := rackStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetUHeight() > 0 {
+		body.SetUHeight(req.GetUHeight())
+	}
+
+	rack, httpResp, err := s.netboxClient.DcimAPI.DcimRacksCreate(ctx).WritableRackRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create rack")
+	}
+	return rackToProto(rack), nil
+}
+
+// UpdateRack aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateRack(ctx context.Context, req *dcim_proto.Rack) (*dcim_proto.Rack, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableRackRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetSite(netbox.Int32AsDeviceWithConfigContextRequestSite(&siteID))
+	}
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetStatus() != "" {
+		st, err := rackStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetUHeight() > 0 {
+		body.SetUHeight(req.GetUHeight())
+	}
+	if req.GetComments() != "" {
+		body.SetComments(req.GetComments())
+	}
+
+	rack, httpResp, err := s.netboxClient.DcimAPI.DcimRacksPartialUpdate(ctx, id).PatchedWritableRackRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update rack")
+	}
+	return rackToProto(rack), nil
+}
+
+func (s *Server) DeleteRack(ctx context.Context, req *dcim_proto.GetRequest) (*dcim_proto.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.DcimAPI.DcimRacksDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete rack")
+	}
+	return &dcim_proto.DeleteResponse{Success: true}, nil
+}
+
+func (s *Server) ListDevices(ctx context.Context, req *dcim_proto.ListRequest) (*dcim_proto.ListDevicesResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.DcimAPI.DcimDevicesList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list devices")
+	}
+
+	results := make([]*dcim_proto.Device, len(list.Results))
+	for i := range list.Results {
+		results[i] = deviceToProto(&list.Results[i])
+	}
+	return &dcim_proto.ListDevicesResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetDevice(ctx context.Context, req *dcim_proto.GetRequest) (*dcim_proto.Device, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	device, httpResp, err := s.netboxClient.DcimAPI.DcimDevicesRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get device")
+	}
+	return deviceToProto(device), nil
+}
+
+func (s *Server) CreateDevice(ctx context.Context, req *dcim_proto.CreateDeviceRequest) (*dcim_proto.Device, error) {
+	deviceTypeID, err := toNetboxID(req.GetDeviceTypeId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "device_type_id is required")
+	}
+	roleID, err := toNetboxID(req.GetRoleId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "role_id is required")
+	}
+	siteID, err := toNetboxID(req.GetSiteId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "site_id is required")
+	}
+
+	body := netbox.NewWritableDeviceWithConfigContextRequest(
+		netbox.Int32AsDeviceBayTemplateRequestDeviceType(&deviceTypeID),
+		netbox.Int32AsDeviceWithConfigContextRequestRole(&roleID),
+		netbox.Int32AsDeviceWithConfigContextRequestSite(&siteID),
+	)
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+
+	device, httpResp, err := s.netboxClient.DcimAPI.DcimDevicesCreate(ctx).WritableDeviceWithConfigContextRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create device")
+	}
+	return deviceToProto(device), nil
+}
+
+// UpdateDevice aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateDevice(ctx context.Context, req *dcim_proto.Device) (*dcim_proto.Device, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableDeviceWithConfigContextRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetDeviceTypeId() != 0 {
+		deviceTypeID, err := toNetboxID(req.GetDeviceTypeId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetDeviceType(netbox.Int32AsDeviceBayTemplateRequestDeviceType(&deviceTypeID))
+	}
+	if req.GetRoleId() != 0 {
+		roleID, err := toNetboxID(req.GetRoleId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetRole(netbox.Int32AsDeviceWithConfigContextRequestRole(&roleID))
+	}
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetSite(netbox.Int32AsDeviceWithConfigContextRequestSite(&siteID))
+	}
+	if req.GetRackId() != 0 {
+		rackID, err := toNetboxID(req.GetRackId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetRack(netbox.Int32AsDeviceWithConfigContextRequestRack(&rackID))
+	}
+	if req.GetStatus() != "" {
+		st, err := deviceStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+
+	device, httpResp, err := s.netboxClient.DcimAPI.DcimDevicesPartialUpdate(ctx, id).PatchedWritableDeviceWithConfigContextRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update device")
+	}
+	return deviceToProto(device), nil
+}
+
+func (s *Server) DeleteDevice(ctx context.Context, req *dcim_proto.GetRequest) (*dcim_proto.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.DcimAPI.DcimDevicesDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete device")
+	}
+	return &dcim_proto.DeleteResponse{Success: true}, nil
+}
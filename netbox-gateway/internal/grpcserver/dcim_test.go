@@ -0,0 +1,123 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"netbox-gateway/proto/dcim_proto"
+
+	"google.golang.org/grpc/codes"
+)
+
+func rackJSON(id int, name string, siteID int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       id,
+		"url":      "http://netbox.test/api/dcim/racks/",
+		"display":  name,
+		"name":     name,
+		"site":     map[string]interface{}{"id": siteID, "url": "http://netbox.test/api/dcim/sites/", "display": "HQ", "name": "HQ", "slug": "hq"},
+		"status":   map[string]string{"value": "active", "label": "Active"},
+		"u_height": 42,
+	}
+}
+
+func TestListRacks(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *dcim_proto.ListRequest
+		httpStatus int
+		wantQuery  string
+		wantCode   codes.Code
+	}{
+		{name: "default page", req: &dcim_proto.ListRequest{}, httpStatus: http.StatusOK, wantQuery: ""},
+		{name: "limit and offset", req: &dcim_proto.ListRequest{Limit: 2, Offset: 4}, httpStatus: http.StatusOK, wantQuery: "limit=2&offset=4"},
+		{name: "negative offset", req: &dcim_proto.ListRequest{Offset: -1}, wantCode: codes.InvalidArgument},
+		{name: "forbidden", req: &dcim_proto.ListRequest{}, httpStatus: http.StatusForbidden, wantCode: codes.PermissionDenied},
+		{name: "NetBox unavailable", req: &dcim_proto.ListRequest{}, httpStatus: http.StatusServiceUnavailable, wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			called := false
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if r.Method != http.MethodGet || r.URL.Path != "/api/dcim/racks/" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				gotQuery = r.URL.RawQuery
+				if tt.httpStatus != http.StatusOK {
+					writeJSON(w, tt.httpStatus, map[string]string{"detail": http.StatusText(tt.httpStatus)})
+					return
+				}
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"count":   12,
+					"results": []interface{}{rackJSON(1, "R01", 3), rackJSON(2, "R02", 3)},
+				})
+			})
+
+			resp, err := s.ListRacks(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				if tt.httpStatus == 0 && called {
+					t.Error("NetBox should not be called for an invalid request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListRacks: %v", err)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("query = %q, want %q", gotQuery, tt.wantQuery)
+			}
+			if resp.GetTotal() != 12 {
+				t.Errorf("total = %d, want 12", resp.GetTotal())
+			}
+			results := resp.GetResults()
+			if len(results) != 2 || results[1].GetName() != "R02" || results[1].GetSiteId() != 3 || results[1].GetStatus() != "active" {
+				t.Errorf("results = %v", results)
+			}
+		})
+	}
+}
+
+func TestGetRack(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         int64
+		httpStatus int
+		wantCode   codes.Code
+	}{
+		{name: "found", id: 7, httpStatus: http.StatusOK},
+		{name: "not found", id: 7, httpStatus: http.StatusNotFound, wantCode: codes.NotFound},
+		{name: "unauthorized", id: 7, httpStatus: http.StatusUnauthorized, wantCode: codes.PermissionDenied},
+		{name: "server error", id: 7, httpStatus: http.StatusInternalServerError, wantCode: codes.Internal},
+		{name: "invalid id", id: -1, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/dcim/racks/7/" {
+					t.Errorf("path = %s", r.URL.Path)
+				}
+				if tt.httpStatus != http.StatusOK {
+					writeJSON(w, tt.httpStatus, map[string]string{"detail": http.StatusText(tt.httpStatus)})
+					return
+				}
+				writeJSON(w, http.StatusOK, rackJSON(7, "R07", 3))
+			})
+
+			rack, err := s.GetRack(context.Background(), &dcim_proto.GetRequest{Id: tt.id})
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetRack: %v", err)
+			}
+			if rack.GetId() != 7 || rack.GetName() != "R07" || rack.GetUHeight() != 42 {
+				t.Errorf("rack = %v", rack)
+			}
+		})
+	}
+}
@@ -1,5 +1,16 @@
 package grpcserver
 
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
 func getStringValue(s *string) string {
 	if s != nil {
 		return *s
@@ -14,8 +25,77 @@ func getInt64Value(i *int64) int64 {
 	return 0
 }
 
-func getTenantID(tenant interface{}) int64 {
-	// Implementar conforme estrutura real do NetBox
-	// Por enquanto retorna 0
+// getTenantID extrai o ID do tenant aninhado retornado pelo NetBox, ou 0 quando o objeto não tem tenant.
+func getTenantID(tenant netbox.NullableBriefTenant) int64 {
+	if t := tenant.Get(); t != nil {
+		return int64(t.GetId())
+	}
 	return 0
 }
+
+// tenantRef converte um tenant_id do protobuf na referência aninhada esperada pelo NetBox.
+func tenantRef(tenantID int64) (netbox.ASNRangeRequestTenant, error) {
+	id, err := toNetboxID(tenantID)
+	if err != nil {
+		return netbox.ASNRangeRequestTenant{}, err
+	}
+	return netbox.Int32AsASNRangeRequestTenant(&id), nil
+}
+
+const maxInt32 = int64(^uint32(0) >> 1)
+
+// Desde o NetBox 4.2 prefixos e clusters não referenciam mais o site diretamente, e sim um escopo genérico.
+const siteScopeType = "dcim.site"
+
+// netboxError converte o erro retornado pelo go-netbox em um status gRPC,
+// usando o código HTTP da resposta do NetBox quando disponível.
+func netboxError(err error, httpResp *http.Response, action string) error {
+	if httpResp == nil {
+		slog.Error("NetBox request failed", "action", action, "error", err)
+		return status.Errorf(codes.Unavailable, "failed to %s: %v", action, err)
+	}
+
+	detail := err.Error()
+	var apiErr *netbox.GenericOpenAPIError
+	if httpResp.StatusCode >= http.StatusBadRequest && errors.As(err, &apiErr) && len(apiErr.Body()) > 0 {
+		detail = strings.TrimSpace(string(apiErr.Body()))
+	}
+
+	var code codes.Code
+	switch httpResp.StatusCode {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusConflict:
+		code = codes.FailedPrecondition
+	case http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusGatewayTimeout:
+		code = codes.Unavailable
+	default:
+		code = codes.Internal
+	}
+	slog.Error("NetBox request failed", "action", action, "status", httpResp.StatusCode, "error", detail)
+	return status.Errorf(code, "failed to %s: %s", action, detail)
+}
+
+// toNetboxID valida e converte um ID do protobuf para o tipo usado pelo go-netbox.
+func toNetboxID(id int64) (int32, error) {
+	if id <= 0 || id > maxInt32 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid id: %d", id)
+	}
+	return int32(id), nil
+}
+
+// pagination normaliza limit/offset recebidos no ListRequest. Valores zero
+// deixam o NetBox aplicar seu tamanho de página padrão.
+func pagination(limit, offset int64) (int32, int32, error) {
+	if limit < 0 || offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	if limit > maxInt32 || offset > maxInt32 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit and offset must not exceed %d", maxInt32)
+	}
+	return int32(limit), int32(offset), nil
+}
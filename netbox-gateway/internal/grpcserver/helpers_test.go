@@ -0,0 +1,97 @@
+package grpcserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"netbox-gateway/internal/netbox_client"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestServer sobe um NetBox falso com httptest, respondido por handler, e retorna um Server
+// apontando para ele.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Server {
+	t.Helper()
+	netbox := httptest.NewServer(handler)
+	t.Cleanup(netbox.Close)
+	client, err := netbox_client.NewClient(netbox.URL, "test-token")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return NewServer(client)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("status code = %v, want %v (err: %v)", got, want, err)
+	}
+}
+
+func TestNetboxErrorMapping(t *testing.T) {
+	tests := []struct {
+		httpStatus int
+		want       codes.Code
+	}{
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusUnauthorized, codes.PermissionDenied},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusConflict, codes.FailedPrecondition},
+		{http.StatusBadGateway, codes.Unavailable},
+		{http.StatusServiceUnavailable, codes.Unavailable},
+		{http.StatusGatewayTimeout, codes.Unavailable},
+		{http.StatusInternalServerError, codes.Internal},
+		{http.StatusTeapot, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.httpStatus), func(t *testing.T) {
+			err := netboxError(errors.New("request failed"), &http.Response{StatusCode: tt.httpStatus}, "get tenant")
+			assertCode(t, err, tt.want)
+		})
+	}
+
+	t.Run("no response", func(t *testing.T) {
+		assertCode(t, netboxError(errors.New("connection refused"), nil, "get tenant"), codes.Unavailable)
+	})
+}
+
+func TestPagination(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit, offset int64
+		wantErr       bool
+	}{
+		{"defaults", 0, 0, false},
+		{"explicit", 50, 100, false},
+		{"negative limit", -1, 0, true},
+		{"negative offset", 10, -5, true},
+		{"limit overflow", maxInt32 + 1, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, offset, err := pagination(tt.limit, tt.offset)
+			if tt.wantErr {
+				assertCode(t, err, codes.InvalidArgument)
+				return
+			}
+			if err != nil {
+				t.Fatalf("pagination: %v", err)
+			}
+			if int64(limit) != tt.limit || int64(offset) != tt.offset {
+				t.Errorf("pagination = (%d, %d), want (%d, %d)", limit, offset, tt.limit, tt.offset)
+			}
+		})
+	}
+}
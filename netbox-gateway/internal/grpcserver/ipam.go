@@ -0,0 +1,420 @@
+package grpcserver
+
+import (
+	"context"
+
+	"netbox-gateway/proto/ipam"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func prefixToProto(p *netbox.Prefix) *ipam.Prefix {
+	prefix := &ipam.Prefix{
+		Id:          int64(p.GetId()),
+		Prefix:      p.GetPrefix(),
+		TenantId:    getTenantID(p.Tenant),
+		Description: getStringValue(p.Description),
+	}
+	if p.GetScopeType() == siteScopeType {
+		prefix.SiteId = int64(p.GetScopeId())
+	}
+	if vlan := p.Vlan.Get(); vlan != nil {
+		prefix.VlanId = int64(vlan.GetId())
+	}
+	if p.Status != nil && p.Status.Value != nil {
+		prefix.Status = string(*p.Status.Value)
+	}
+	return prefix
+}
+
+func ipAddressToProto(a *netbox.IPAddress) *ipam.IPAddress {
+	address := &ipam.IPAddress{
+		Id:          int64(a.GetId()),
+		Address:     a.GetAddress(),
+		TenantId:    getTenantID(a.Tenant),
+		Description: getStringValue(a.Description),
+	}
+	if a.Status != nil && a.Status.Value != nil {
+		address.Status = string(*a.Status.Value)
+	}
+	return address
+}
+
+func vlanToProto(v *netbox.VLAN) *ipam.VLAN {
+	vlan := &ipam.VLAN{
+		Id:   int64(v.GetId()),
+		Vid:  v.GetVid(),
+		Name: v.GetName(),
+	}
+	if site := v.Site.Get(); site != nil {
+		vlan.SiteId = int64(site.GetId())
+	}
+	if v.Status != nil && v.Status.Value != nil {
+		vlan.Status = string(*v.Status.Value)
+	}
+	return vlan
+}
+
+func prefixStatus(value string) (*netbox.PatchedWritablePrefixRequestStatus, error) {
+	st, err := netbox.NewPatchedWritablePrefixRequestStatusFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func ipAddressStatus(value string) (*netbox.PatchedWritableIPAddressRequestStatus, error) {
+	st, err := netbox.NewPatchedWritableIPAddressRequestStatusFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func vlanStatus(value string) (*netbox.PatchedWritableVLANRequestStatus, error) {
+	st, err := netbox.NewPatchedWritableVLANRequestStatusFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func (s *Server) ListPrefixes(ctx context.Context, req *ipam.ListRequest) (*ipam.ListPrefixesResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.IpamAPI.IpamPrefixesList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list prefixes")
+	}
+
+	results := make([]*ipam.Prefix, len(list.Results))
+	for i := range list.Results {
+		results[i] = prefixToProto(&list.Results[i])
+	}
+	return &ipam.ListPrefixesResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetPrefix(ctx context.Context, req *ipam.GetRequest) (*ipam.Prefix, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	prefix, httpResp, err := s.netboxClient.IpamAPI.IpamPrefixesRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get prefix")
+	}
+	return prefixToProto(prefix), nil
+}
+
+func (s *Server) CreatePrefix(ctx context.Context, req *ipam.CreatePrefixRequest) (*ipam.Prefix, error) {
+	if req.GetPrefix() == "" {
+		return nil, status.Error(codes.InvalidArgument, "prefix is required")
+	}
+	body := netbox.NewWritablePrefixRequest(req.GetPrefix())
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetStatus() != "" {
+		st, err := prefixStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+
+	prefix, httpResp, err := s.netboxClient.IpamAPI.IpamPrefixesCreate(ctx).WritablePrefixRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create prefix")
+	}
+	return prefixToProto(prefix), nil
+}
+
+// UpdatePrefix aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdatePrefix(ctx context.Context, req *ipam.Prefix) (*ipam.Prefix, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritablePrefixRequest()
+	if req.GetPrefix() != "" {
+		body.SetPrefix(req.GetPrefix())
+	}
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetScopeType(siteScopeType)
+		body.SetScopeId(siteID)
+	}
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetVlanId() != 0 {
+		vlanID, err := toNetboxID(req.GetVlanId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetVlan(netbox.Int32AsInterfaceRequestUntaggedVlan(&vlanID))
+	}
+	if req.GetStatus() != "" {
+		st, err := prefixStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	prefix, httpResp, err := s.netboxClient.IpamAPI.IpamPrefixesPartialUpdate(ctx, id).PatchedWritablePrefixRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update prefix")
+	}
+	return prefixToProto(prefix), nil
+}
+
+func (s *Server) DeletePrefix(ctx context.Context, req *ipam.GetRequest) (*ipam.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.IpamAPI.IpamPrefixesDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete prefix")
+	}
+	return &ipam.DeleteResponse{Success: true}, nil
+}
+
+func (s *Server) ListIPAddresses(ctx context.Context, req *ipam.ListRequest) (*ipam.ListIPAddressesResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.IpamAPI.IpamIpAddressesList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list IP addresses")
+	}
+
+	results := make([]*ipam.IPAddress, len(list.Results))
+	for i := range list.Results {
+		results[i] = ipAddressToProto(&list.Results[i])
+	}
+	return &ipam.ListIPAddressesResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetIPAddress(ctx context.Context, req *ipam.GetRequest) (*ipam.IPAddress, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	address, httpResp, err := s.netboxClient.IpamAPI.IpamIpAddressesRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get IP address")
+	}
+	return ipAddressToProto(address), nil
+}
+
+func (s *Server) CreateIPAddress(ctx context.Context, req *ipam.CreateIPAddressRequest) (*ipam.IPAddress, error) {
+	if req.GetAddress() == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	body := netbox.NewWritableIPAddressRequest(req.GetAddress())
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetStatus() != "" {
+		st, err := ipAddressStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+
+	address, httpResp, err := s.netboxClient.IpamAPI.IpamIpAddressesCreate(ctx).WritableIPAddressRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create IP address")
+	}
+	return ipAddressToProto(address), nil
+}
+
+// UpdateIPAddress aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateIPAddress(ctx context.Context, req *ipam.IPAddress) (*ipam.IPAddress, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableIPAddressRequest()
+	if req.GetAddress() != "" {
+		body.SetAddress(req.GetAddress())
+	}
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetStatus() != "" {
+		st, err := ipAddressStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	address, httpResp, err := s.netboxClient.IpamAPI.IpamIpAddressesPartialUpdate(ctx, id).PatchedWritableIPAddressRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update IP address")
+	}
+	return ipAddressToProto(address), nil
+}
+
+func (s *Server) DeleteIPAddress(ctx context.Context, req *ipam.GetRequest) (*ipam.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.IpamAPI.IpamIpAddressesDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete IP address")
+	}
+	return &ipam.DeleteResponse{Success: true}, nil
+}
+
+func (s *Server) ListVLANs(ctx context.Context, req *ipam.ListRequest) (*ipam.ListVLANsResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.IpamAPI.IpamVlansList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list VLANs")
+	}
+
+	results := make([]*ipam.VLAN, len(list.Results))
+	for i := range list.Results {
+		results[i] = vlanToProto(&list.Results[i])
+	}
+	return &ipam.ListVLANsResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetVLAN(ctx context.Context, req *ipam.GetRequest) (*ipam.VLAN, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	vlan, httpResp, err := s.netboxClient.IpamAPI.IpamVlansRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get VLAN")
+	}
+	return vlanToProto(vlan), nil
+}
+
+func (s *Server) CreateVLAN(ctx context.Context, req *ipam.CreateVLANRequest) (*ipam.VLAN, error) {
+	if req.GetVid() <= 0 || req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "vid and name are required")
+	}
+	body := netbox.NewWritableVLANRequest(req.GetVid(), req.GetName())
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetSite(netbox.Int32AsPatchedWritableVLANRequestSite(&siteID))
+	}
+
+	vlan, httpResp, err := s.netboxClient.IpamAPI.IpamVlansCreate(ctx).WritableVLANRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create VLAN")
+	}
+	return vlanToProto(vlan), nil
+}
+
+// UpdateVLAN aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateVLAN(ctx context.Context, req *ipam.VLAN) (*ipam.VLAN, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableVLANRequest()
+	if req.GetVid() > 0 {
+		body.SetVid(req.GetVid())
+	}
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetSite(netbox.Int32AsPatchedWritableVLANRequestSite(&siteID))
+	}
+	if req.GetStatus() != "" {
+		st, err := vlanStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+
+	vlan, httpResp, err := s.netboxClient.IpamAPI.IpamVlansPartialUpdate(ctx, id).PatchedWritableVLANRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update VLAN")
+	}
+	return vlanToProto(vlan), nil
+}
+
+func (s *Server) DeleteVLAN(ctx context.Context, req *ipam.GetRequest) (*ipam.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.IpamAPI.IpamVlansDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete VLAN")
+	}
+	return &ipam.DeleteResponse{Success: true}, nil
+}
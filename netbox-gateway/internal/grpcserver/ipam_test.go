@@ -0,0 +1,127 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"netbox-gateway/proto/ipam"
+
+	"google.golang.org/grpc/codes"
+)
+
+func prefixJSON(id int, prefix string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         id,
+		"url":        "http://netbox.test/api/ipam/prefixes/",
+		"display":    prefix,
+		"family":     map[string]interface{}{"value": 4, "label": "IPv4"},
+		"prefix":     prefix,
+		"scope_type": siteScopeType,
+		"scope_id":   3,
+		"tenant":     tenantJSON(5, "Team A", "team-a"),
+		"status":     map[string]string{"value": "active", "label": "Active"},
+		"children":   0,
+		"_depth":     0,
+	}
+}
+
+func TestListPrefixes(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *ipam.ListRequest
+		httpStatus int
+		wantQuery  string
+		wantCode   codes.Code
+	}{
+		{name: "default page", req: &ipam.ListRequest{}, httpStatus: http.StatusOK, wantQuery: ""},
+		{name: "limit and offset", req: &ipam.ListRequest{Limit: 50, Offset: 100}, httpStatus: http.StatusOK, wantQuery: "limit=50&offset=100"},
+		{name: "limit overflow", req: &ipam.ListRequest{Limit: maxInt32 + 1}, wantCode: codes.InvalidArgument},
+		{name: "bad filter", req: &ipam.ListRequest{}, httpStatus: http.StatusBadRequest, wantCode: codes.InvalidArgument},
+		{name: "bad gateway", req: &ipam.ListRequest{}, httpStatus: http.StatusBadGateway, wantCode: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			called := false
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if r.Method != http.MethodGet || r.URL.Path != "/api/ipam/prefixes/" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				gotQuery = r.URL.RawQuery
+				if tt.httpStatus != http.StatusOK {
+					writeJSON(w, tt.httpStatus, map[string]string{"detail": http.StatusText(tt.httpStatus)})
+					return
+				}
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"count":   120,
+					"results": []interface{}{prefixJSON(1, "10.0.0.0/24"), prefixJSON(2, "10.0.1.0/24")},
+				})
+			})
+
+			resp, err := s.ListPrefixes(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				if tt.httpStatus == 0 && called {
+					t.Error("NetBox should not be called for an invalid request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListPrefixes: %v", err)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("query = %q, want %q", gotQuery, tt.wantQuery)
+			}
+			if resp.GetTotal() != 120 {
+				t.Errorf("total = %d, want 120", resp.GetTotal())
+			}
+			results := resp.GetResults()
+			if len(results) != 2 || results[1].GetPrefix() != "10.0.1.0/24" || results[1].GetSiteId() != 3 || results[1].GetTenantId() != 5 {
+				t.Errorf("results = %v", results)
+			}
+		})
+	}
+}
+
+func TestGetPrefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         int64
+		httpStatus int
+		wantCode   codes.Code
+	}{
+		{name: "found", id: 7, httpStatus: http.StatusOK},
+		{name: "not found", id: 7, httpStatus: http.StatusNotFound, wantCode: codes.NotFound},
+		{name: "conflict", id: 7, httpStatus: http.StatusConflict, wantCode: codes.FailedPrecondition},
+		{name: "gateway timeout", id: 7, httpStatus: http.StatusGatewayTimeout, wantCode: codes.Unavailable},
+		{name: "invalid id", id: 0, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/ipam/prefixes/7/" {
+					t.Errorf("path = %s", r.URL.Path)
+				}
+				if tt.httpStatus != http.StatusOK {
+					writeJSON(w, tt.httpStatus, map[string]string{"detail": http.StatusText(tt.httpStatus)})
+					return
+				}
+				writeJSON(w, http.StatusOK, prefixJSON(7, "192.168.0.0/16"))
+			})
+
+			prefix, err := s.GetPrefix(context.Background(), &ipam.GetRequest{Id: tt.id})
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetPrefix: %v", err)
+			}
+			if prefix.GetId() != 7 || prefix.GetPrefix() != "192.168.0.0/16" || prefix.GetStatus() != "active" {
+				t.Errorf("prefix = %v", prefix)
+			}
+		})
+	}
+}
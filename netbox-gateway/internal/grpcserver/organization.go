@@ -0,0 +1,231 @@
+package grpcserver
+
+import (
+	"context"
+
+	"netbox-gateway/proto/organization"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func tenantToProto(t *netbox.Tenant) *organization.Tenant {
+	return &organization.Tenant{
+		Id:          int64(t.GetId()),
+		Name:        t.GetName(),
+		Slug:        t.GetSlug(),
+		Description: getStringValue(t.Description),
+	}
+}
+
+func siteToProto(s *netbox.Site) *organization.Site {
+	site := &organization.Site{
+		Id:          int64(s.GetId()),
+		Name:        s.GetName(),
+		Slug:        s.GetSlug(),
+		Description: getStringValue(s.Description),
+	}
+	if s.Status != nil && s.Status.Value != nil {
+		site.Status = string(*s.Status.Value)
+	}
+	return site
+}
+
+func siteStatus(value string) (*netbox.LocationStatusValue, error) {
+	st, err := netbox.NewLocationStatusValueFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func (s *Server) ListTenants(ctx context.Context, req *organization.ListRequest) (*organization.ListTenantsResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.TenancyAPI.TenancyTenantsList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list tenants")
+	}
+
+	results := make([]*organization.Tenant, len(list.Results))
+	for i := range list.Results {
+		results[i] = tenantToProto(&list.Results[i])
+	}
+	return &organization.ListTenantsResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetTenant(ctx context.Context, req *organization.GetRequest) (*organization.Tenant, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	tenant, httpResp, err := s.netboxClient.TenancyAPI.TenancyTenantsRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get tenant")
+	}
+	return tenantToProto(tenant), nil
+}
+
+func (s *Server) CreateTenant(ctx context.Context, req *organization.CreateTenantRequest) (*organization.Tenant, error) {
+	if req.GetName() == "" || req.GetSlug() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name and slug are required")
+	}
+	body := netbox.NewTenantRequest(req.GetName(), req.GetSlug())
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	tenant, httpResp, err := s.netboxClient.TenancyAPI.TenancyTenantsCreate(ctx).TenantRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create tenant")
+	}
+	return tenantToProto(tenant), nil
+}
+
+// UpdateTenant aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateTenant(ctx context.Context, req *organization.Tenant) (*organization.Tenant, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedTenantRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetSlug() != "" {
+		body.SetSlug(req.GetSlug())
+	}
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	tenant, httpResp, err := s.netboxClient.TenancyAPI.TenancyTenantsPartialUpdate(ctx, id).PatchedTenantRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update tenant")
+	}
+	return tenantToProto(tenant), nil
+}
+
+func (s *Server) DeleteTenant(ctx context.Context, req *organization.GetRequest) (*organization.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.TenancyAPI.TenancyTenantsDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete tenant")
+	}
+	return &organization.DeleteResponse{Success: true}, nil
+}
+
+func (s *Server) ListSites(ctx context.Context, req *organization.ListRequest) (*organization.ListSitesResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.DcimAPI.DcimSitesList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list sites")
+	}
+
+	results := make([]*organization.Site, len(list.Results))
+	for i := range list.Results {
+		results[i] = siteToProto(&list.Results[i])
+	}
+	return &organization.ListSitesResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetSite(ctx context.Context, req *organization.GetRequest) (*organization.Site, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	site, httpResp, err := s.netboxClient.DcimAPI.DcimSitesRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get site")
+	}
+	return siteToProto(site), nil
+}
+
+func (s *Server) CreateSite(ctx context.Context, req *organization.CreateSiteRequest) (*organization.Site, error) {
+	if req.GetName() == "" || req.GetSlug() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name and slug are required")
+	}
+	body := netbox.NewWritableSiteRequest(req.GetName(), req.GetSlug())
+	if req.GetStatus() != "" {
+		st, err := siteStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	site, httpResp, err := s.netboxClient.DcimAPI.DcimSitesCreate(ctx).WritableSiteRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create site")
+	}
+	return siteToProto(site), nil
+}
+
+// UpdateSite aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateSite(ctx context.Context, req *organization.Site) (*organization.Site, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableSiteRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetSlug() != "" {
+		body.SetSlug(req.GetSlug())
+	}
+	if req.GetStatus() != "" {
+		st, err := siteStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetDescription() != "" {
+		body.SetDescription(req.GetDescription())
+	}
+
+	site, httpResp, err := s.netboxClient.DcimAPI.DcimSitesPartialUpdate(ctx, id).PatchedWritableSiteRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update site")
+	}
+	return siteToProto(site), nil
+}
+
+func (s *Server) DeleteSite(ctx context.Context, req *organization.GetRequest) (*organization.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.DcimAPI.DcimSitesDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete site")
+	}
+	return &organization.DeleteResponse{Success: true}, nil
+}
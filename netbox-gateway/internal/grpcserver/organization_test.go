@@ -0,0 +1,240 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"netbox-gateway/proto/organization"
+
+	"google.golang.org/grpc/codes"
+)
+
+func tenantJSON(id int, name, slug string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          id,
+		"url":         "http://netbox.test/api/tenancy/tenants/",
+		"display":     name,
+		"name":        name,
+		"slug":        slug,
+		"description": "tenant " + slug,
+	}
+}
+
+func siteJSON(id int, name, slug, siteStatus string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      id,
+		"url":     "http://netbox.test/api/dcim/sites/",
+		"display": name,
+		"name":    name,
+		"slug":    slug,
+		"status":  map[string]string{"value": siteStatus, "label": strings.ToUpper(siteStatus[:1]) + siteStatus[1:]},
+	}
+}
+
+func TestListTenants(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *organization.ListRequest
+		wantQuery string
+		wantCode  codes.Code
+	}{
+		{name: "default page", req: &organization.ListRequest{}, wantQuery: ""},
+		{name: "limit and offset", req: &organization.ListRequest{Limit: 2, Offset: 4}, wantQuery: "limit=2&offset=4"},
+		{name: "negative limit", req: &organization.ListRequest{Limit: -1}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			called := false
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				if r.Method != http.MethodGet || r.URL.Path != "/api/tenancy/tenants/" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				gotQuery = r.URL.RawQuery
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"count":   42,
+					"results": []interface{}{tenantJSON(1, "Team A", "team-a"), tenantJSON(2, "Team B", "team-b")},
+				})
+			})
+
+			resp, err := s.ListTenants(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				if called {
+					t.Error("NetBox should not be called for an invalid request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListTenants: %v", err)
+			}
+			if gotQuery != tt.wantQuery {
+				t.Errorf("query = %q, want %q", gotQuery, tt.wantQuery)
+			}
+			if resp.GetTotal() != 42 {
+				t.Errorf("total = %d, want 42", resp.GetTotal())
+			}
+			if len(resp.GetResults()) != 2 || resp.GetResults()[1].GetSlug() != "team-b" {
+				t.Errorf("results = %v", resp.GetResults())
+			}
+		})
+	}
+}
+
+func TestGetTenant(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         int64
+		httpStatus int
+		wantCode   codes.Code
+	}{
+		{name: "found", id: 7, httpStatus: http.StatusOK},
+		{name: "not found", id: 8, httpStatus: http.StatusNotFound, wantCode: codes.NotFound},
+		{name: "invalid id", id: 0, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.httpStatus != http.StatusOK {
+					writeJSON(w, tt.httpStatus, map[string]string{"detail": "No Tenant matches the given query."})
+					return
+				}
+				if r.URL.Path != "/api/tenancy/tenants/7/" {
+					t.Errorf("path = %s", r.URL.Path)
+				}
+				writeJSON(w, http.StatusOK, tenantJSON(7, "Team A", "team-a"))
+			})
+
+			tenant, err := s.GetTenant(context.Background(), &organization.GetRequest{Id: tt.id})
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTenant: %v", err)
+			}
+			if tenant.GetId() != 7 || tenant.GetName() != "Team A" || tenant.GetDescription() != "tenant team-a" {
+				t.Errorf("tenant = %v", tenant)
+			}
+		})
+	}
+}
+
+func TestCreateTenant(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *organization.CreateTenantRequest
+		httpStatus int
+		wantCode   codes.Code
+	}{
+		{name: "created", req: &organization.CreateTenantRequest{Name: "Team A", Slug: "team-a", Description: "infra"}, httpStatus: http.StatusCreated},
+		{name: "validation error", req: &organization.CreateTenantRequest{Name: "Team A", Slug: "team-a"}, httpStatus: http.StatusBadRequest, wantCode: codes.InvalidArgument},
+		{name: "missing slug", req: &organization.CreateTenantRequest{Name: "Team A"}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/tenancy/tenants/" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("decode body: %v", err)
+				}
+				if tt.httpStatus == http.StatusBadRequest {
+					writeJSON(w, http.StatusBadRequest, map[string][]string{"slug": {"tenant with this slug already exists."}})
+					return
+				}
+				if body["name"] != tt.req.GetName() || body["slug"] != tt.req.GetSlug() || body["description"] != tt.req.GetDescription() {
+					t.Errorf("body = %v", body)
+				}
+				writeJSON(w, http.StatusCreated, tenantJSON(3, tt.req.GetName(), tt.req.GetSlug()))
+			})
+
+			tenant, err := s.CreateTenant(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTenant: %v", err)
+			}
+			if tenant.GetId() != 3 || tenant.GetSlug() != "team-a" {
+				t.Errorf("tenant = %v", tenant)
+			}
+		})
+	}
+}
+
+func TestListSites(t *testing.T) {
+	var gotQuery string
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dcim/sites/" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		gotQuery = r.URL.RawQuery
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"count":   3,
+			"results": []interface{}{siteJSON(1, "HQ", "hq", "active")},
+		})
+	})
+
+	resp, err := s.ListSites(context.Background(), &organization.ListRequest{Limit: 1, Offset: 2})
+	if err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	if gotQuery != "limit=1&offset=2" {
+		t.Errorf("query = %q", gotQuery)
+	}
+	if resp.GetTotal() != 3 || len(resp.GetResults()) != 1 || resp.GetResults()[0].GetStatus() != "active" {
+		t.Errorf("response = %v", resp)
+	}
+}
+
+func TestGetSiteNotFound(t *testing.T) {
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]string{"detail": "No Site matches the given query."})
+	})
+	_, err := s.GetSite(context.Background(), &organization.GetRequest{Id: 99})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestCreateSite(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *organization.CreateSiteRequest
+		wantCode codes.Code
+	}{
+		{name: "created", req: &organization.CreateSiteRequest{Name: "HQ", Slug: "hq", Status: "planned"}},
+		{name: "invalid status", req: &organization.CreateSiteRequest{Name: "HQ", Slug: "hq", Status: "exploded"}, wantCode: codes.InvalidArgument},
+		{name: "missing name", req: &organization.CreateSiteRequest{Slug: "hq"}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]interface{}
+				json.NewDecoder(r.Body).Decode(&body)
+				if body["status"] != tt.req.GetStatus() {
+					t.Errorf("status = %v, want %q", body["status"], tt.req.GetStatus())
+				}
+				writeJSON(w, http.StatusCreated, siteJSON(5, tt.req.GetName(), tt.req.GetSlug(), tt.req.GetStatus()))
+			})
+
+			site, err := s.CreateSite(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assertCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateSite: %v", err)
+			}
+			if site.GetId() != 5 || site.GetStatus() != "planned" {
+				t.Errorf("site = %v", site)
+			}
+		})
+	}
+}
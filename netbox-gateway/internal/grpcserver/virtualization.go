@@ -0,0 +1,337 @@
+package grpcserver
+
+import (
+	"context"
+	"math"
+
+	"netbox-gateway/proto/virtualization"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// O NetBox armazena o disco das VMs em MB (base decimal, como exibido na interface). disk_mb expõe
+// o valor exato; disk_gb é mantido por compatibilidade, arredondado para o GB mais próximo.
+const mbPerGB = 1000
+
+func clusterToProto(c *netbox.Cluster) *virtualization.Cluster {
+	cluster := &virtualization.Cluster{
+		Id:     int64(c.GetId()),
+		Name:   c.GetName(),
+		TypeId: int64(c.Type.GetId()),
+	}
+	if c.GetScopeType() == siteScopeType {
+		cluster.SiteId = int64(c.GetScopeId())
+	}
+	return cluster
+}
+
+func virtualMachineToProto(vm *netbox.VirtualMachineWithConfigContext) *virtualization.VirtualMachine {
+	machine := &virtualization.VirtualMachine{
+		Id:       int64(vm.GetId()),
+		Name:     vm.GetName(),
+		TenantId: getTenantID(vm.Tenant),
+		Vcpus:    int32(vm.GetVcpus()),
+		MemoryMb: vm.GetMemory(),
+		DiskMb:   vm.GetDisk(),
+		DiskGb:   int32((int64(vm.GetDisk()) + mbPerGB/2) / mbPerGB),
+	}
+	if cluster := vm.Cluster.Get(); cluster != nil {
+		machine.ClusterId = int64(cluster.GetId())
+	}
+	if role := vm.Role.Get(); role != nil {
+		machine.RoleId = int64(role.GetId())
+	}
+	if vm.Status != nil && vm.Status.Value != nil {
+		machine.Status = string(*vm.Status.Value)
+	}
+	return machine
+}
+
+// diskGBToMB converte disk_gb para MB, recusando valores que não cabem no campo int32 do NetBox.
+func diskGBToMB(gb int32) (int32, error) {
+	mb := int64(gb) * mbPerGB
+	if mb > math.MaxInt32 {
+		return 0, status.Errorf(codes.InvalidArgument, "disk_gb %d exceeds the maximum disk size of %d MB", gb, math.MaxInt32)
+	}
+	return int32(mb), nil
+}
+
+// virtualMachineRoleRef converte um role_id do protobuf na referência aninhada esperada pelo NetBox.
+func virtualMachineRoleRef(roleID int64) (netbox.PatchedWritableVirtualMachineWithConfigContextRequestRole, error) {
+	id, err := toNetboxID(roleID)
+	if err != nil {
+		return netbox.PatchedWritableVirtualMachineWithConfigContextRequestRole{}, err
+	}
+	return netbox.Int32AsPatchedWritableVirtualMachineWithConfigContextRequestRole(&id), nil
+}
+
+func virtualMachineStatus(value string) (*netbox.PatchedWritableVirtualMachineWithConfigContextRequestStatus, error) {
+	st, err := netbox.NewPatchedWritableVirtualMachineWithConfigContextRequestStatusFromValue(value)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st, nil
+}
+
+func (s *Server) ListClusters(ctx context.Context, req *virtualization.ListRequest) (*virtualization.ListClustersResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.VirtualizationAPI.VirtualizationClustersList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list clusters")
+	}
+
+	results := make([]*virtualization.Cluster, len(list.Results))
+	for i := range list.Results {
+		results[i] = clusterToProto(&list.Results[i])
+	}
+	return &virtualization.ListClustersResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetCluster(ctx context.Context, req *virtualization.GetRequest) (*virtualization.Cluster, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	cluster, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationClustersRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get cluster")
+	}
+	return clusterToProto(cluster), nil
+}
+
+func (s *Server) CreateCluster(ctx context.Context, req *virtualization.CreateClusterRequest) (*virtualization.Cluster, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	typeID, err := toNetboxID(req.GetTypeId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "type_id is required")
+	}
+	body := netbox.NewWritableClusterRequest(req.GetName(), netbox.Int32AsClusterRequestType(&typeID))
+
+	cluster, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationClustersCreate(ctx).WritableClusterRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create cluster")
+	}
+	return clusterToProto(cluster), nil
+}
+
+// UpdateCluster aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateCluster(ctx context.Context, req *virtualization.Cluster) (*virtualization.Cluster, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableClusterRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetTypeId() != 0 {
+		typeID, err := toNetboxID(req.GetTypeId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetType(netbox.Int32AsClusterRequestType(&typeID))
+	}
+	if req.GetSiteId() != 0 {
+		siteID, err := toNetboxID(req.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetScopeType(siteScopeType)
+		body.SetScopeId(siteID)
+	}
+
+	cluster, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationClustersPartialUpdate(ctx, id).PatchedWritableClusterRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update cluster")
+	}
+	return clusterToProto(cluster), nil
+}
+
+func (s *Server) DeleteCluster(ctx context.Context, req *virtualization.GetRequest) (*virtualization.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationClustersDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete cluster")
+	}
+	return &virtualization.DeleteResponse{Success: true}, nil
+}
+
+func (s *Server) ListVirtualMachines(ctx context.Context, req *virtualization.ListRequest) (*virtualization.ListVMsResponse, error) {
+	limit, offset, err := pagination(req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	apiReq := s.netboxClient.VirtualizationAPI.VirtualizationVirtualMachinesList(ctx)
+	if limit > 0 {
+		apiReq = apiReq.Limit(limit)
+	}
+	if offset > 0 {
+		apiReq = apiReq.Offset(offset)
+	}
+	list, httpResp, err := apiReq.Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "list virtual machines")
+	}
+
+	results := make([]*virtualization.VirtualMachine, len(list.Results))
+	for i := range list.Results {
+		results[i] = virtualMachineToProto(&list.Results[i])
+	}
+	return &virtualization.ListVMsResponse{Results: results, Total: int64(list.GetCount())}, nil
+}
+
+func (s *Server) GetVirtualMachine(ctx context.Context, req *virtualization.GetRequest) (*virtualization.VirtualMachine, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	vm, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationVirtualMachinesRetrieve(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "get virtual machine")
+	}
+	return virtualMachineToProto(vm), nil
+}
+
+func (s *Server) CreateVirtualMachine(ctx context.Context, req *virtualization.CreateVMRequest) (*virtualization.VirtualMachine, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.GetVcpus() < 0 || req.GetMemoryMb() < 0 || req.GetDiskMb() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "vcpus, memory_mb and disk_mb must not be negative")
+	}
+	body := netbox.NewWritableVirtualMachineWithConfigContextRequest(req.GetName())
+	if req.GetClusterId() != 0 {
+		clusterID, err := toNetboxID(req.GetClusterId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetCluster(netbox.Int32AsDeviceWithConfigContextRequestCluster(&clusterID))
+	}
+	if req.GetStatus() != "" {
+		st, err := virtualMachineStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetRoleId() != 0 {
+		role, err := virtualMachineRoleRef(req.GetRoleId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetRole(role)
+	}
+	if req.GetVcpus() > 0 {
+		body.SetVcpus(float64(req.GetVcpus()))
+	}
+	if req.GetMemoryMb() > 0 {
+		body.SetMemory(req.GetMemoryMb())
+	}
+	if req.GetDiskMb() > 0 {
+		body.SetDisk(req.GetDiskMb())
+	}
+
+	vm, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationVirtualMachinesCreate(ctx).WritableVirtualMachineWithConfigContextRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "create virtual machine")
+	}
+	return virtualMachineToProto(vm), nil
+}
+
+// UpdateVirtualMachine aplica uma atualização parcial: apenas campos não vazios são enviados ao NetBox.
+func (s *Server) UpdateVirtualMachine(ctx context.Context, req *virtualization.VirtualMachine) (*virtualization.VirtualMachine, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	body := netbox.NewPatchedWritableVirtualMachineWithConfigContextRequest()
+	if req.GetName() != "" {
+		body.SetName(req.GetName())
+	}
+	if req.GetStatus() != "" {
+		st, err := virtualMachineStatus(req.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		body.SetStatus(*st)
+	}
+	if req.GetClusterId() != 0 {
+		clusterID, err := toNetboxID(req.GetClusterId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetCluster(netbox.Int32AsDeviceWithConfigContextRequestCluster(&clusterID))
+	}
+	if req.GetTenantId() != 0 {
+		tenant, err := tenantRef(req.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetTenant(tenant)
+	}
+	if req.GetVcpus() > 0 {
+		body.SetVcpus(float64(req.GetVcpus()))
+	}
+	if req.GetMemoryMb() > 0 {
+		body.SetMemory(req.GetMemoryMb())
+	}
+	if req.GetRoleId() != 0 {
+		role, err := virtualMachineRoleRef(req.GetRoleId())
+		if err != nil {
+			return nil, err
+		}
+		body.SetRole(role)
+	}
+	// disk_mb tem precedência: quem lê a VM e a devolve inteira envia os dois campos, e só disk_mb é exato.
+	if req.GetDiskMb() > 0 {
+		body.SetDisk(req.GetDiskMb())
+	} else if req.GetDiskGb() > 0 {
+		diskMB, err := diskGBToMB(req.GetDiskGb())
+		if err != nil {
+			return nil, err
+		}
+		body.SetDisk(diskMB)
+	}
+
+	vm, httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationVirtualMachinesPartialUpdate(ctx, id).PatchedWritableVirtualMachineWithConfigContextRequest(*body).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "update virtual machine")
+	}
+	return virtualMachineToProto(vm), nil
+}
+
+func (s *Server) DeleteVirtualMachine(ctx context.Context, req *virtualization.GetRequest) (*virtualization.DeleteResponse, error) {
+	id, err := toNetboxID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := s.netboxClient.VirtualizationAPI.VirtualizationVirtualMachinesDestroy(ctx, id).Execute()
+	if err != nil {
+		return nil, netboxError(err, httpResp, "delete virtual machine")
+	}
+	return &virtualization.DeleteResponse{Success: true}, nil
+}
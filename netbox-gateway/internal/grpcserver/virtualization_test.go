@@ -0,0 +1,103 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"testing"
+
+	"netbox-gateway/proto/virtualization"
+
+	"github.com/netbox-community/go-netbox/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func virtualMachineJSON(body map[string]interface{}) map[string]interface{} {
+	vm := map[string]interface{}{
+		"id":                 10,
+		"url":                "http://netbox.test/api/virtualization/virtual-machines/10/",
+		"display":            body["name"],
+		"name":               body["name"],
+		"virtual_disk_count": 0,
+		"disk":               body["disk"],
+		"memory":             body["memory"],
+		"vcpus":              body["vcpus"],
+	}
+	if role, ok := body["role"].(float64); ok {
+		vm["role"] = map[string]interface{}{"id": role, "url": "http://netbox.test/", "display": "app", "name": "app", "slug": "app", "_depth": 0}
+	}
+	return vm
+}
+
+func TestCreateVirtualMachine(t *testing.T) {
+	var body map[string]interface{}
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/virtualization/virtual-machines/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		writeJSON(w, http.StatusCreated, virtualMachineJSON(body))
+	})
+
+	vm, err := s.CreateVirtualMachine(context.Background(), &virtualization.CreateVMRequest{
+		Name: "app-01", ClusterId: 1, TenantId: 2, RoleId: 3, Vcpus: 4, MemoryMb: 8192, DiskMb: 1500,
+	})
+	if err != nil {
+		t.Fatalf("CreateVirtualMachine: %v", err)
+	}
+	want := map[string]float64{"cluster": 1, "tenant": 2, "role": 3, "vcpus": 4, "memory": 8192, "disk": 1500}
+	for field, value := range want {
+		if body[field] != value {
+			t.Errorf("body[%q] = %v, want %v", field, body[field], value)
+		}
+	}
+	if vm.GetDiskMb() != 1500 || vm.GetDiskGb() != 2 || vm.GetRoleId() != 3 {
+		t.Errorf("vm = %v", vm)
+	}
+}
+
+func TestUpdateVirtualMachineDisk(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *virtualization.VirtualMachine
+		wantDisk interface{}
+	}{
+		{name: "disk_mb is exact", req: &virtualization.VirtualMachine{Id: 10, DiskMb: 1500}, wantDisk: float64(1500)},
+		{name: "disk_mb wins over rounded disk_gb", req: &virtualization.VirtualMachine{Id: 10, DiskMb: 1500, DiskGb: 2}, wantDisk: float64(1500)},
+		{name: "disk_gb only", req: &virtualization.VirtualMachine{Id: 10, DiskGb: 2}, wantDisk: float64(2000)},
+		{name: "unchanged", req: &virtualization.VirtualMachine{Id: 10, Name: "app-01"}, wantDisk: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&body)
+				body["name"] = "app-01"
+				writeJSON(w, http.StatusOK, virtualMachineJSON(body))
+			})
+			if _, err := s.UpdateVirtualMachine(context.Background(), tt.req); err != nil {
+				t.Fatalf("UpdateVirtualMachine: %v", err)
+			}
+			if body["disk"] != tt.wantDisk {
+				t.Errorf("disk = %v, want %v", body["disk"], tt.wantDisk)
+			}
+		})
+	}
+}
+
+func TestVirtualMachineDiskBounds(t *testing.T) {
+	vm := virtualMachineToProto(&netbox.VirtualMachineWithConfigContext{Disk: *netbox.NewNullableInt32(netbox.PtrInt32(math.MaxInt32))})
+	if vm.GetDiskMb() != math.MaxInt32 || vm.GetDiskGb() != 2147484 {
+		t.Errorf("disk_mb = %d, disk_gb = %d", vm.GetDiskMb(), vm.GetDiskGb())
+	}
+
+	s := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	_, err := s.UpdateVirtualMachine(context.Background(), &virtualization.VirtualMachine{Id: 10, DiskGb: math.MaxInt32 / 100})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateVirtualMachine error = %v, want InvalidArgument", err)
+	}
+}
@@ -334,15 +334,18 @@ func (x *ListClustersResponse) GetTotal() int64 {
 }
 
 type VirtualMachine struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	ClusterId     int64                  `protobuf:"varint,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	TenantId      int64                  `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Vcpus         int32                  `protobuf:"varint,6,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
-	MemoryMb      int32                  `protobuf:"varint,7,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
-	DiskGb        int32                  `protobuf:"varint,8,opt,name=disk_gb,json=diskGb,proto3" json:"disk_gb,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ClusterId int64                  `protobuf:"varint,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	TenantId  int64                  `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Vcpus     int32                  `protobuf:"varint,6,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	MemoryMb  int32                  `protobuf:"varint,7,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	// Deprecated: arredondado para o GB mais próximo; use disk_mb, a unidade em que o NetBox armazena o disco.
+	DiskGb        int32 `protobuf:"varint,8,opt,name=disk_gb,json=diskGb,proto3" json:"disk_gb,omitempty"`
+	DiskMb        int32 `protobuf:"varint,9,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb,omitempty"`
+	RoleId        int64 `protobuf:"varint,10,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -433,11 +436,30 @@ func (x *VirtualMachine) GetDiskGb() int32 {
 	return 0
 }
 
+func (x *VirtualMachine) GetDiskMb() int32 {
+	if x != nil {
+		return x.DiskMb
+	}
+	return 0
+}
+
+func (x *VirtualMachine) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
 type CreateVMRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ClusterId     int64                  `protobuf:"varint,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	TenantId      int64                  `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RoleId        int64                  `protobuf:"varint,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Vcpus         int32                  `protobuf:"varint,6,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	MemoryMb      int32                  `protobuf:"varint,7,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	DiskMb        int32                  `protobuf:"varint,8,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -493,6 +515,41 @@ func (x *CreateVMRequest) GetStatus() string {
 	return ""
 }
 
+func (x *CreateVMRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreateVMRequest) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *CreateVMRequest) GetVcpus() int32 {
+	if x != nil {
+		return x.Vcpus
+	}
+	return 0
+}
+
+func (x *CreateVMRequest) GetMemoryMb() int32 {
+	if x != nil {
+		return x.MemoryMb
+	}
+	return 0
+}
+
+func (x *CreateVMRequest) GetDiskMb() int32 {
+	if x != nil {
+		return x.DiskMb
+	}
+	return 0
+}
+
 type ListVMsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Results       []*VirtualMachine      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
@@ -568,7 +625,7 @@ const file_proto_virtualization_virtualization_proto_rawDesc = "" +
 	"\atype_id\x18\x02 \x01(\x03R\x06typeId\"e\n" +
 	"\x14ListClustersResponse\x127\n" +
 	"\aresults\x18\x01 \x03(\v2\x1d.virtualization_proto.ClusterR\aresults\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xd4\x01\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x86\x02\n" +
 	"\x0eVirtualMachine\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -578,12 +635,20 @@ const file_proto_virtualization_virtualization_proto_rawDesc = "" +
 	"\ttenant_id\x18\x05 \x01(\x03R\btenantId\x12\x14\n" +
 	"\x05vcpus\x18\x06 \x01(\x05R\x05vcpus\x12\x1b\n" +
 	"\tmemory_mb\x18\a \x01(\x05R\bmemoryMb\x12\x17\n" +
-	"\adisk_gb\x18\b \x01(\x05R\x06diskGb\"\\\n" +
+	"\adisk_gb\x18\b \x01(\x05R\x06diskGb\x12\x17\n" +
+	"\adisk_mb\x18\t \x01(\x05R\x06diskMb\x12\x17\n" +
+	"\arole_id\x18\n" +
+	" \x01(\x03R\x06roleId\"\xde\x01\n" +
 	"\x0fCreateVMRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"cluster_id\x18\x02 \x01(\x03R\tclusterId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"g\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\x03R\btenantId\x12\x17\n" +
+	"\arole_id\x18\x05 \x01(\x03R\x06roleId\x12\x14\n" +
+	"\x05vcpus\x18\x06 \x01(\x05R\x05vcpus\x12\x1b\n" +
+	"\tmemory_mb\x18\a \x01(\x05R\bmemoryMb\x12\x17\n" +
+	"\adisk_mb\x18\b \x01(\x05R\x06diskMb\"g\n" +
 	"\x0fListVMsResponse\x12>\n" +
 	"\aresults\x18\x01 \x03(\v2$.virtualization_proto.VirtualMachineR\aresults\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total2\xb0\a\n" +
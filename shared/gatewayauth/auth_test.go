@@ -0,0 +1,294 @@
+package gatewayauth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/secret_proto.SecretService/ReadSecret"
+
+func TestMethodAllowed(t *testing.T) {
+	tests := []struct {
+		name       string
+		patterns   []string
+		fullMethod string
+		want       bool
+	}{
+		{name: "no patterns", fullMethod: testMethod, want: true},
+		{name: "method name", patterns: []string{"ReadSecret"}, fullMethod: testMethod, want: true},
+		{name: "method glob", patterns: []string{"Read*"}, fullMethod: testMethod, want: true},
+		{name: "method glob mismatch", patterns: []string{"Write*"}, fullMethod: testMethod},
+		{name: "full method", patterns: []string{"/secret_proto.SecretService/ReadSecret"}, fullMethod: testMethod, want: true},
+		{name: "service glob", patterns: []string{"/secret_proto.SecretService/*"}, fullMethod: testMethod, want: true},
+		{name: "other service", patterns: []string{"/ipam_proto.IpamService/*"}, fullMethod: testMethod},
+		{name: "method pattern does not match service", patterns: []string{"secret_proto.SecretService*"}, fullMethod: testMethod},
+		{name: "any of several", patterns: []string{"Write*", "Read*"}, fullMethod: testMethod, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := methodAllowed(tt.patterns, tt.fullMethod); got != tt.want {
+				t.Errorf("methodAllowed(%q, %q) = %v, want %v", tt.patterns, tt.fullMethod, got, tt.want)
+			}
+		})
+	}
+}
+
+func writeTokenFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Set the time explicitly: two writes within the file system's timestamp resolution would
+	// otherwise look unchanged to Watch.
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTokenSetAuthorize(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tokens")
+	writeTokenFile(t, file, "# internal tokens\n\ncurrent\nreader Read*, List*\n", time.Now())
+	fromFile, err := NewTokenSet("", nil, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	static, err := NewTokenSet("static", []string{"Read*"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		tokens      *TokenSet
+		token       string
+		fullMethod  string
+		wantValid   bool
+		wantAllowed bool
+	}{
+		{name: "file token without patterns", tokens: fromFile, token: "current", fullMethod: "/secret_proto.SecretService/WriteSecret", wantValid: true, wantAllowed: true},
+		{name: "file token allowed", tokens: fromFile, token: "reader", fullMethod: "/secret_proto.SecretService/ListSecrets", wantValid: true, wantAllowed: true},
+		{name: "file token not allowed", tokens: fromFile, token: "reader", fullMethod: "/secret_proto.SecretService/WriteSecret", wantValid: true},
+		{name: "comment is not a token", tokens: fromFile, token: "# internal tokens", fullMethod: testMethod},
+		{name: "unknown token", tokens: fromFile, token: "other", fullMethod: testMethod},
+		{name: "token prefix", tokens: fromFile, token: "curr", fullMethod: testMethod},
+		{name: "empty token", tokens: fromFile, token: "", fullMethod: testMethod},
+		{name: "static token allowed", tokens: static, token: "static", fullMethod: testMethod, wantValid: true, wantAllowed: true},
+		{name: "static token not allowed", tokens: static, token: "static", fullMethod: "/secret_proto.SecretService/WriteSecret", wantValid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, allowed := tt.tokens.Authorize(tt.token, tt.fullMethod)
+			if valid != tt.wantValid || allowed != tt.wantAllowed {
+				t.Errorf("Authorize = (%v, %v), want (%v, %v)", valid, allowed, tt.wantValid, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestNewTokenSetErrors(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	writeTokenFile(t, empty, "# no tokens\n", time.Now())
+	badPattern := filepath.Join(dir, "bad")
+	writeTokenFile(t, badPattern, "token [\n", time.Now())
+
+	if _, err := NewTokenSet("", nil, ""); err == nil {
+		t.Error("no token and no file: expected an error")
+	}
+	if _, err := NewTokenSet("static", []string{"["}, ""); err == nil {
+		t.Error("invalid static pattern: expected an error")
+	}
+	if _, err := NewTokenSet("", nil, filepath.Join(dir, "missing")); err == nil {
+		t.Error("missing file: expected an error")
+	}
+	if _, err := NewTokenSet("", nil, empty); err == nil {
+		t.Error("file without tokens: expected an error")
+	}
+	if _, err := NewTokenSet("", nil, badPattern); err == nil {
+		t.Error("invalid pattern in file: expected an error")
+	}
+}
+
+func incomingContext(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func TestAuthInterceptorUnary(t *testing.T) {
+	tokens, err := NewTokenSet("secret", []string{"Read*"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unary := NewAuthInterceptor(tokens).Unary()
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		wantCode   codes.Code
+	}{
+		{name: "valid token", ctx: incomingContext("authorization", "Bearer secret"), fullMethod: testMethod, wantCode: codes.OK},
+		{name: "no metadata", ctx: context.Background(), fullMethod: testMethod, wantCode: codes.Unauthenticated},
+		{name: "no authorization header", ctx: incomingContext("other", "value"), fullMethod: testMethod, wantCode: codes.Unauthenticated},
+		{name: "not a bearer token", ctx: incomingContext("authorization", "Basic secret"), fullMethod: testMethod, wantCode: codes.Unauthenticated},
+		{name: "wrong token", ctx: incomingContext("authorization", "Bearer wrong"), fullMethod: testMethod, wantCode: codes.Unauthenticated},
+		{name: "method not allowed", ctx: incomingContext("authorization", "Bearer secret"), fullMethod: "/secret_proto.SecretService/WriteSecret", wantCode: codes.PermissionDenied},
+		{name: "health without token", ctx: context.Background(), fullMethod: "/grpc.health.v1.Health/Check", wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "response", nil
+			}
+			_, err := unary(tt.ctx, "request", &grpc.UnaryServerInfo{FullMethod: tt.fullMethod}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthInterceptorStream(t *testing.T) {
+	tokens, err := NewTokenSet("secret", []string{"Walk*"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream := NewAuthInterceptor(tokens).Stream()
+	walk := &grpc.StreamServerInfo{FullMethod: "/secret_proto.SecretService/WalkSecrets", IsServerStream: true}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		info     *grpc.StreamServerInfo
+		wantCode codes.Code
+	}{
+		{name: "valid token", ctx: incomingContext("authorization", "Bearer secret"), info: walk, wantCode: codes.OK},
+		{name: "wrong token", ctx: incomingContext("authorization", "Bearer wrong"), info: walk, wantCode: codes.Unauthenticated},
+		{name: "no token", ctx: context.Background(), info: walk, wantCode: codes.Unauthenticated},
+		{name: "method not allowed", ctx: incomingContext("authorization", "Bearer secret"), info: &grpc.StreamServerInfo{FullMethod: "/secret_proto.SecretService/Export"}, wantCode: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				called = true
+				return nil
+			}
+			err := stream(nil, &testServerStream{ctx: tt.ctx}, tt.info, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+func TestWatchReloadsTokens(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tokens")
+	start := time.Now().Add(-time.Hour)
+	writeTokenFile(t, file, "old\n", start)
+	tokens, err := NewTokenSet("", nil, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tokens.Watch(ctx, 5*time.Millisecond)
+
+	writeTokenFile(t, file, "new\n", start.Add(time.Minute))
+	waitFor(t, func() bool {
+		valid, _ := tokens.Authorize("new", testMethod)
+		return valid
+	})
+	if valid, _ := tokens.Authorize("old", testMethod); valid {
+		t.Error("old token still valid after reload")
+	}
+
+	// A broken file keeps the tokens loaded before it.
+	writeTokenFile(t, file, "# emptied by mistake\n", start.Add(2*time.Minute))
+	time.Sleep(50 * time.Millisecond)
+	if valid, _ := tokens.Authorize("new", testMethod); !valid {
+		t.Error("failed reload dropped the previous tokens")
+	}
+}
+
+// Requests served during a reload see either the old or the new token list, never an empty or
+// partial one: "shared" is in both files and must stay valid throughout. Run with -race.
+func TestReloadSwapsTokensAtomically(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tokens")
+	contents := []string{"first\nshared Read*\n", "second\nshared List*\n"}
+	writeTokenFile(t, file, contents[0], time.Now())
+	tokens, err := NewTokenSet("", nil, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if valid, _ := tokens.Authorize("shared", testMethod); !valid {
+					t.Error("shared token invalid during reload")
+					return
+				}
+			}
+		}()
+	}
+	for i := range 200 {
+		writeTokenFile(t, file, contents[i%2], time.Now())
+		if err := tokens.reload(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	// The last reload loaded the second file, methods included.
+	if valid, _ := tokens.Authorize("first", testMethod); valid {
+		t.Error("token from the first file still valid")
+	}
+	if _, allowed := tokens.Authorize("shared", "/secret_proto.SecretService/ListSecrets"); !allowed {
+		t.Error("shared token does not have the methods of the second file")
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before the deadline")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
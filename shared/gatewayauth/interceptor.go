@@ -0,0 +1,79 @@
+package gatewayauth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// healthServicePrefix identifies the standard gRPC health service. Every gateway registers it and
+// serves it without a token, so that orchestrator probes can reach it; it only reports serving status.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// AuthInterceptor checks the internal bearer token of every gRPC call against a TokenSet. It is
+// shared by the vault, zabbix and netbox gateways.
+type AuthInterceptor struct {
+	tokens *TokenSet
+}
+
+func NewAuthInterceptor(tokens *TokenSet) *AuthInterceptor {
+	return &AuthInterceptor{tokens: tokens}
+}
+
+func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := interceptor.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := interceptor.authorize(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func (interceptor *AuthInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) < 1 {
+		return status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+	token, found := strings.CutPrefix(authHeaders[0], "Bearer ")
+	if !found {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	valid, allowed := interceptor.tokens.Authorize(token, fullMethod)
+	if !valid {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "token is not allowed to call %s", fullMethod)
+	}
+	return nil
+}
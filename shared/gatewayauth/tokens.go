@@ -0,0 +1,193 @@
+package gatewayauth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// TokenReloadInterval is how often Watch checks the token file for changes.
+const TokenReloadInterval = 10 * time.Second
+
+type tokenEntry struct {
+	token   []byte
+	methods []string
+}
+
+// TokenSet holds the internal tokens accepted by the gateway. Keeping more than one valid token
+// (current and next) lets the API switch credentials without downtime.
+type TokenSet struct {
+	path string
+
+	mu      sync.RWMutex
+	tokens  []tokenEntry
+	modTime time.Time
+}
+
+// NewTokenSet loads the tokens from file, one per line (blank lines and lines starting with "#"
+// are ignored). A token may be followed by a comma-separated list of method patterns it is allowed
+// to call; without one, every method is allowed. When file is empty, the single static token is
+// used instead, restricted to methods if given.
+func NewTokenSet(token string, methods []string, file string) (*TokenSet, error) {
+	ts := &TokenSet{path: file}
+	if file == "" {
+		if token == "" {
+			return nil, fmt.Errorf("no internal auth token configured")
+		}
+		if err := validateMethodPatterns(methods); err != nil {
+			return nil, err
+		}
+		ts.tokens = []tokenEntry{{token: []byte(token), methods: methods}}
+		return ts, nil
+	}
+	if err := ts.reload(); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// Authorize compares token against every accepted token in constant time and reports whether
+// it is valid and, if so, whether it may call fullMethod (e.g. "/secret_proto.SecretService/ReadSecret").
+func (ts *TokenSet) Authorize(token, fullMethod string) (valid bool, allowed bool) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	match := -1
+	for i, candidate := range ts.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), candidate.token) == 1 && match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		return false, false
+	}
+	return true, methodAllowed(ts.tokens[match].methods, fullMethod)
+}
+
+// methodAllowed matches fullMethod against glob patterns. Patterns starting with "/" match the
+// full method name (e.g. "/ipam_proto.IpamService/*"); the others match only the method part
+// (e.g. "List*"). An empty list allows every method.
+func methodAllowed(patterns []string, fullMethod string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, pattern := range patterns {
+		target := method
+		if strings.HasPrefix(pattern, "/") {
+			target = fullMethod
+		}
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func validateMethodPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid method pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// ParseMethodPatterns splits a comma-separated list of method patterns, ignoring empty entries.
+func ParseMethodPatterns(value string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// Watch re-reads the token file when it changes on disk or when the process receives SIGHUP,
+// until ctx is cancelled. A failed reload keeps the previous tokens.
+func (ts *TokenSet) Watch(ctx context.Context, interval time.Duration) {
+	if ts.path == "" {
+		return
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("SIGHUP received, reloading internal auth tokens")
+		case <-ticker.C:
+			if !ts.changed() {
+				continue
+			}
+		}
+		if err := ts.reload(); err != nil {
+			slog.Error("Failed to reload internal auth tokens", "error", err)
+			continue
+		}
+		slog.Info("Internal auth tokens reloaded", "path", ts.path)
+	}
+}
+
+func (ts *TokenSet) changed() bool {
+	info, err := os.Stat(ts.path)
+	if err != nil {
+		return false
+	}
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return !info.ModTime().Equal(ts.modTime)
+}
+
+func (ts *TokenSet) reload() error {
+	info, err := os.Stat(ts.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat token file: %w", err)
+	}
+	raw, err := os.ReadFile(ts.path)
+	if err != nil {
+		return fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	var tokens []tokenEntry
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		entry := tokenEntry{token: []byte(fields[0]), methods: ParseMethodPatterns(strings.Join(fields[1:], ","))}
+		if err := validateMethodPatterns(entry.methods); err != nil {
+			return err
+		}
+		tokens = append(tokens, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to parse token file: %w", err)
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("token file %s has no tokens", ts.path)
+	}
+
+	ts.mu.Lock()
+	ts.tokens = tokens
+	ts.modTime = info.ModTime()
+	ts.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,400 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for a leaf valid as both server and client.
+func (ca *testCA) issue(t *testing.T, serial int64, dnsName string) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func (ca *testCA) keyPair(t *testing.T, serial int64, dnsName string) tls.Certificate {
+	t.Helper()
+	certPEM, keyPEM := ca.issue(t, serial, dnsName)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// writeFiles writes the files and moves their modification time forward, so the change is
+// detected even on filesystems with coarse timestamps.
+func writeFiles(t *testing.T, files map[string][]byte, modTime time.Time) {
+	t.Helper()
+	for path, data := range files {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// serveTLS accepts connections with the reloader's server configuration and writes "ok" to
+// each client that completes the handshake.
+func serveTLS(t *testing.T, r *Reloader) string {
+	t.Helper()
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", r.ServerConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if conn.(*tls.Conn).Handshake() == nil {
+					conn.Write([]byte("ok"))
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// dial connects as a client and returns the serial number of the certificate served by the gateway.
+// The response is read because, with TLS 1.3, a rejected client certificate only surfaces after the handshake.
+func dial(addr string, roots *x509.CertPool, clientCert *tls.Certificate) (int64, error) {
+	config := &tls.Config{RootCAs: roots, ServerName: "gateway.test", MinVersion: tls.VersionTLS12}
+	if clientCert != nil {
+		config.Certificates = []tls.Certificate{*clientCert}
+	}
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	if _, err := io.ReadFull(conn, make([]byte, 2)); err != nil {
+		return 0, err
+	}
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestReloaderServesAndRotates(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	ca := newTestCA(t, "test CA")
+	certPEM, keyPEM := ca.issue(t, 100, "gateway.test")
+	start := time.Now().Add(-time.Minute)
+	writeFiles(t, map[string][]byte{caFile: ca.pem, certFile: certPEM, keyFile: keyPEM}, start)
+
+	r, err := NewReloader(caFile, certFile, keyFile)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Watch(ctx, 10*time.Millisecond)
+	addr := serveTLS(t, r)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	client := ca.keyPair(t, 200, "api.test")
+
+	if serial, err := dial(addr, roots, &client); err != nil || serial != 100 {
+		t.Fatalf("dial = (%d, %v), want serial 100", serial, err)
+	}
+	if _, err := dial(addr, roots, nil); err == nil {
+		t.Error("connection without a client certificate was accepted")
+	}
+	otherCA := newTestCA(t, "other CA")
+	foreign := otherCA.keyPair(t, 300, "api.test")
+	if _, err := dial(addr, roots, &foreign); err == nil {
+		t.Error("client certificate signed by another CA was accepted")
+	}
+
+	// Rotate to a new certificate issued by the same CA.
+	certPEM, keyPEM = ca.issue(t, 101, "gateway.test")
+	writeFiles(t, map[string][]byte{certFile: certPEM, keyFile: keyPEM}, start.Add(time.Second))
+	waitForSerial(t, addr, roots, &client, 101)
+
+	// A broken certificate file keeps the previous certificate in use.
+	writeFiles(t, map[string][]byte{certFile: []byte("not a certificate")}, start.Add(2*time.Second))
+	time.Sleep(50 * time.Millisecond)
+	if serial, err := dial(addr, roots, &client); err != nil || serial != 101 {
+		t.Fatalf("dial after failed reload = (%d, %v), want serial 101", serial, err)
+	}
+
+	// Rotate the CA: clients of the old CA are rejected and clients of the new one are accepted.
+	newCA := newTestCA(t, "new CA")
+	certPEM, keyPEM = newCA.issue(t, 102, "gateway.test")
+	writeFiles(t, map[string][]byte{caFile: newCA.pem, certFile: certPEM, keyFile: keyPEM}, start.Add(3*time.Second))
+	newRoots := x509.NewCertPool()
+	newRoots.AddCert(newCA.cert)
+	newClient := newCA.keyPair(t, 201, "api.test")
+	waitForSerial(t, addr, newRoots, &newClient, 102)
+	if _, err := dial(addr, newRoots, &client); err == nil {
+		t.Error("client certificate of the old CA was accepted after the CA rotation")
+	}
+}
+
+func waitForSerial(t *testing.T, addr string, roots *x509.CertPool, client *tls.Certificate, want int64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		serial, err := dial(addr, roots, client)
+		if err == nil && serial == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("gateway still serves (%d, %v), want serial %d", serial, err, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// fakeGateway accepts any client certificate and replies with its serial number. The certificate it
+// serves can be swapped during the test.
+type fakeGateway struct {
+	mu   sync.Mutex
+	cert tls.Certificate
+	addr string
+}
+
+func newFakeGateway(t *testing.T, cert tls.Certificate) *fakeGateway {
+	t.Helper()
+	g := &fakeGateway{cert: cert}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAnyClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			return &g.cert, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	g.addr = listener.Addr().String()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				tlsConn := conn.(*tls.Conn)
+				if tlsConn.Handshake() != nil {
+					return
+				}
+				peer := tlsConn.ConnectionState().PeerCertificates[0]
+				io.WriteString(conn, peer.SerialNumber.String())
+			}()
+		}
+	}()
+	return g
+}
+
+func (g *fakeGateway) serve(cert tls.Certificate) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cert = cert
+}
+
+// dialGateway connects to the gateway with the reloader's client configuration and returns the serial
+// number of the client certificate the gateway received.
+func dialGateway(g *fakeGateway, r *Reloader, serverName string) (int64, error) {
+	conn, err := tls.Dial("tcp", g.addr, r.ClientConfig(serverName))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	raw, err := io.ReadAll(conn)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(raw), 10, 64)
+}
+
+func TestReloaderClientConfig(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	ca := newTestCA(t, "test CA")
+	certPEM, keyPEM := ca.issue(t, 200, "api.test")
+	start := time.Now().Add(-time.Minute)
+	writeFiles(t, map[string][]byte{caFile: ca.pem, certFile: certPEM, keyFile: keyPEM}, start)
+
+	r, err := NewReloader(caFile, certFile, keyFile)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Watch(ctx, 10*time.Millisecond)
+	gateway := newFakeGateway(t, ca.keyPair(t, 100, "gateway.test"))
+
+	if serial, err := dialGateway(gateway, r, "gateway.test"); err != nil || serial != 200 {
+		t.Fatalf("dial = (%d, %v), want client serial 200", serial, err)
+	}
+	if _, err := dialGateway(gateway, r, "other.test"); err == nil {
+		t.Error("gateway certificate accepted for the wrong server name")
+	}
+	// Without serverName the address host (127.0.0.1) is used, and it is not in the certificate.
+	if _, err := dialGateway(gateway, r, ""); err == nil {
+		t.Error("gateway certificate accepted for the address host")
+	}
+
+	otherCA := newTestCA(t, "other CA")
+	gateway.serve(otherCA.keyPair(t, 101, "gateway.test"))
+	if _, err := dialGateway(gateway, r, "gateway.test"); err == nil {
+		t.Error("gateway certificate signed by another CA was accepted")
+	}
+	gateway.serve(ca.keyPair(t, 100, "gateway.test"))
+
+	// Rotate the client certificate.
+	certPEM, keyPEM = ca.issue(t, 201, "api.test")
+	writeFiles(t, map[string][]byte{certFile: certPEM, keyFile: keyPEM}, start.Add(time.Second))
+	waitForClientSerial(t, gateway, r, 201)
+
+	// A broken key file keeps the previous certificate in use.
+	writeFiles(t, map[string][]byte{keyFile: []byte("not a key")}, start.Add(2*time.Second))
+	time.Sleep(50 * time.Millisecond)
+	if serial, err := dialGateway(gateway, r, "gateway.test"); err != nil || serial != 201 {
+		t.Fatalf("dial after failed reload = (%d, %v), want client serial 201", serial, err)
+	}
+
+	// Rotate the CA: after the reload, a gateway certificate from the new CA is accepted and one from the old CA is not.
+	newCA := newTestCA(t, "new CA")
+	certPEM, keyPEM = newCA.issue(t, 202, "api.test")
+	writeFiles(t, map[string][]byte{caFile: newCA.pem, certFile: certPEM, keyFile: keyPEM}, start.Add(3*time.Second))
+	gateway.serve(newCA.keyPair(t, 102, "gateway.test"))
+	waitForClientSerial(t, gateway, r, 202)
+	gateway.serve(ca.keyPair(t, 103, "gateway.test"))
+	if _, err := dialGateway(gateway, r, "gateway.test"); err == nil {
+		t.Error("gateway certificate of the old CA was accepted after the CA rotation")
+	}
+}
+
+func waitForClientSerial(t *testing.T, g *fakeGateway, r *Reloader, want int64) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		serial, err := dialGateway(g, r, "gateway.test")
+		if err == nil && serial == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("gateway received (%d, %v), want client serial %d", serial, err, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewReloaderRejectsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, "test CA")
+	certPEM, keyPEM := ca.issue(t, 100, "gateway.test")
+	_, otherKeyPEM := ca.issue(t, 101, "gateway.test")
+
+	tests := []struct {
+		name              string
+		ca, cert, keyFile []byte
+	}{
+		{name: "mismatched key", ca: ca.pem, cert: certPEM, keyFile: otherKeyPEM},
+		{name: "empty CA", ca: []byte("no certificates here"), cert: certPEM, keyFile: keyPEM},
+		{name: "invalid certificate", ca: ca.pem, cert: []byte("invalid"), keyFile: keyPEM},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caFile := filepath.Join(dir, "ca.pem")
+			certFile := filepath.Join(dir, "cert.pem")
+			keyFile := filepath.Join(dir, "key.pem")
+			writeFiles(t, map[string][]byte{caFile: tt.ca, certFile: tt.cert, keyFile: tt.keyFile}, time.Now())
+			if _, err := NewReloader(caFile, certFile, keyFile); err == nil {
+				t.Error("NewReloader succeeded, want error")
+			}
+		})
+	}
+
+	if _, err := NewReloader(filepath.Join(dir, "missing.pem"), filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")); err == nil {
+		t.Error("NewReloader with a missing CA file succeeded, want error")
+	}
+}
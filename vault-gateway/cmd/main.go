@@ -6,14 +6,18 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"shared/gatewayauth"
+	"shared/tlsconfig"
 	"syscall"
-	"vault-gateway/internal/auth"
 	"vault-gateway/internal/config"
 	"vault-gateway/internal/grpcserver"
 	"vault-gateway/internal/vault_client"
 	"vault-gateway/proto/vault"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -31,8 +35,28 @@ func main() {
 	slog.Info("Configuration loaded successfully", "vault_address", cfg.VaultSrvAddr)
 	slog.Info("Vault Gateway starting...")
 
-	authInterceptor := auth.NewAuthInterceptor(cfg.VaultGtwAuthToken)
-	gServer := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor.Unary()))
+	authTokens, err := gatewayauth.NewTokenSet(cfg.VaultGtwAuthToken, gatewayauth.ParseMethodPatterns(cfg.VaultGtwAuthMethods), cfg.VaultGtwAuthFile)
+	if err != nil {
+		slog.Error("Failed to load internal auth tokens", "error", err)
+		os.Exit(1)
+	}
+	go authTokens.Watch(appCtx, gatewayauth.TokenReloadInterval)
+	authInterceptor := gatewayauth.NewAuthInterceptor(authTokens)
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary(), grpcserver.NamespaceUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream(), grpcserver.NamespaceStreamInterceptor()),
+	}
+	if cfg.TLSEnabled {
+		tlsReloader, err := tlsconfig.NewReloader(cfg.TLSCAFile, cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			slog.Error("Failed to load TLS certificates", "error", err)
+			os.Exit(1)
+		}
+		go tlsReloader.Watch(appCtx, tlsconfig.ReloadInterval)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsReloader.ServerConfig())))
+		slog.Info("mTLS enabled for gRPC server")
+	}
+	gServer := grpc.NewServer(serverOpts...)
 	slog.Info("gRPC server created")
 
 	slog.Info("Creating Vault client...")
@@ -53,6 +77,18 @@ func main() {
 	vault.RegisterSecretServiceServer(gServer, server)
 	slog.Info("Vault service registered")
 
+	// The health service reports NOT_SERVING while the gateway has no valid Vault token.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(gServer, healthServer)
+	vaultClient.OnHealthChange(func(healthy bool) {
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if !healthy {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		healthServer.SetServingStatus("", servingStatus)
+		healthServer.SetServingStatus(vault.SecretService_ServiceDesc.ServiceName, servingStatus)
+	})
+
 	go func() {
 		slog.Info("Starting gRPC server...", "port", ":5555")
 		if err := gServer.Serve(lis); err != nil {
@@ -64,6 +100,7 @@ func main() {
 
 	<-appCtx.Done()
 	slog.Info("Shutting down Vault Gateway...")
+	healthServer.Shutdown()
 	gServer.GracefulStop()
 	slog.Info("Vault Gateway stopped gracefully")
 }
@@ -0,0 +1,63 @@
+// Command migrate-hidden soft-deletes, once, the secrets hidden by the old soft delete of the API,
+// which wrote "hidden": "true" into their data. The API no longer reads that flag, so until this
+// runs those secrets are visible again. It logs in to Vault with the same environment variables as
+// the gateway (VAULT_SERVER_ADDR, VAULT_AUTH_METHOD, ...); the namespace comes from VAULT_NAMESPACE.
+//
+//	migrate-hidden -path kv/data/ -dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"vault-gateway/internal/config"
+	"vault-gateway/internal/migrate"
+	"vault-gateway/internal/vault_client"
+)
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	path := flag.String("path", "", "Vault folder to migrate (KV v2 data path or KV v1 path)")
+	dryRun := flag.Bool("dry-run", false, "only print the hidden secrets")
+	flag.Parse()
+	if *path == "" {
+		fmt.Fprintln(os.Stderr, "usage: migrate-hidden -path <vault-path> [-dry-run]")
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	cfg, err := config.LoadVaultConfig()
+	if err != nil {
+		slog.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	vc, err := vault_client.NewVaultClient(ctx, cfg)
+	if err != nil {
+		slog.Error("Failed to create Vault client", "error", err)
+		os.Exit(1)
+	}
+
+	hidden, err := migrate.HiddenSecrets(ctx, vc, *path, *dryRun)
+	for _, secret := range hidden {
+		switch {
+		case secret.Deleted:
+			fmt.Printf("deleted   %s (version %d)\n", secret.Path, secret.Version)
+		case secret.Version == 0:
+			fmt.Printf("kv-v1     %s (delete by hand)\n", secret.Path)
+		default:
+			fmt.Printf("hidden    %s (version %d)\n", secret.Path, secret.Version)
+		}
+	}
+	if err != nil {
+		slog.Error("Hidden secret migration failed", "path", *path, "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Hidden secrets migrated", "path", *path, "secrets", len(hidden), "dry_run", *dryRun)
+}
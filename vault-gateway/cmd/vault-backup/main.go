@@ -0,0 +1,244 @@
+// Command vault-backup takes encrypted snapshots of KV secrets and restores them, possibly under
+// another path or on another Vault cluster. It logs in to Vault with the same environment
+// variables as the gateway (VAULT_SERVER_ADDR, VAULT_AUTH_METHOD, ...).
+//
+//	vault-backup keygen -o backup.key
+//	vault-backup backup -path kv/data/app -recipient age1... -o app.age
+//	vault-backup restore -i app.age -identity backup.key -path kv/data/app-copy -conflict skip -dry-run
+//
+// Backups are age files (https://age-encryption.org), so they can also be opened with the age
+// command and keys from age-keygen. They can be encrypted with a passphrase instead, read from
+// -passphrase-file or from the VAULT_BACKUP_PASSPHRASE environment variable.
+//
+// A restore is not atomic: if it fails halfway, the secrets already written are printed and stay in
+// Vault. Running it again with -conflict skip completes it.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"vault-gateway/internal/backup"
+	"vault-gateway/internal/config"
+	"vault-gateway/internal/vault_client"
+
+	"filippo.io/age"
+)
+
+const usage = `usage:
+  vault-backup keygen [-o identity-file]
+  vault-backup backup -path <vault-path> -o <file> (-recipient <public-key> | -passphrase-file <file>)
+  vault-backup restore -i <file> (-identity <file> | -passphrase-file <file>) [-path <vault-path>] [-conflict fail|skip|overwrite] [-dry-run]
+`
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch os.Args[1] {
+	case "keygen":
+		err = runKeygen(os.Args[2:])
+	case "backup":
+		err = runBackup(ctx, os.Args[2:])
+	case "restore":
+		err = runRestore(ctx, os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		slog.Error("vault-backup failed", "command", os.Args[1], "error", err)
+		os.Exit(1)
+	}
+}
+
+func runKeygen(args []string) error {
+	flags := flag.NewFlagSet("keygen", flag.ExitOnError)
+	output := flags.String("o", "", "write the identity to this file instead of stdout")
+	flags.Parse(args)
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return err
+	}
+	content := fmt.Sprintf("# public key: %s\n%s\n", identity.Recipient(), identity)
+	if *output == "" {
+		fmt.Print(content)
+		return nil
+	}
+	file, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create identity file: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(content); err != nil {
+		return fmt.Errorf("failed to write identity file: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Public key: %s\n", identity.Recipient())
+	return file.Close()
+}
+
+func runBackup(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	path := flags.String("path", "", "Vault folder to back up (KV v2 data or metadata path)")
+	output := flags.String("o", "", "backup file to write")
+	recipient := flags.String("recipient", "", "age X25519 public key (age1...) to encrypt the backup to")
+	passphraseFile := flags.String("passphrase-file", "", "file with the passphrase to encrypt the backup with")
+	namespace := flags.String("namespace", "", "Vault Enterprise namespace")
+	concurrency := flags.Int("concurrency", 4, "maximum concurrent Vault requests")
+	flags.Parse(args)
+	if *path == "" || *output == "" {
+		return errors.New("-path and -o are required")
+	}
+
+	var encryptTo age.Recipient
+	if *recipient != "" {
+		x25519Recipient, err := age.ParseX25519Recipient(*recipient)
+		if err != nil {
+			return fmt.Errorf("invalid recipient: %w", err)
+		}
+		encryptTo = x25519Recipient
+	} else {
+		passphrase, err := readPassphrase(*passphraseFile)
+		if err != nil {
+			return err
+		}
+		scryptRecipient, err := age.NewScryptRecipient(passphrase)
+		if err != nil {
+			return err
+		}
+		encryptTo = scryptRecipient
+	}
+
+	vc, err := newVaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = vault_client.WithNamespace(ctx, *namespace)
+	snapshot, err := backup.Create(ctx, vc, *path, *concurrency)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(*output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+	defer file.Close()
+	if err := backup.Save(file, snapshot, encryptTo); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write backup file: %w", err)
+	}
+	slog.Info("Backup written", "path", snapshot.Root, "secrets", len(snapshot.Secrets), "file", *output)
+	return nil
+}
+
+func runRestore(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	input := flags.String("i", "", "backup file to restore")
+	identityFile := flags.String("identity", "", "age identity file with the private key the backup was encrypted to")
+	passphraseFile := flags.String("passphrase-file", "", "file with the passphrase the backup was encrypted with")
+	path := flags.String("path", "", "Vault folder to restore into (default: the folder the backup was taken from)")
+	conflict := flags.String("conflict", string(backup.ConflictFail), "what to do with secrets that already exist: fail, skip or overwrite")
+	dryRun := flags.Bool("dry-run", false, "only print what would be restored")
+	namespace := flags.String("namespace", "", "Vault Enterprise namespace")
+	flags.Parse(args)
+	if *input == "" {
+		return errors.New("-i is required")
+	}
+	conflictPolicy, err := backup.ParseConflictPolicy(*conflict)
+	if err != nil {
+		return err
+	}
+
+	var identities []age.Identity
+	if *identityFile != "" {
+		file, err := os.Open(*identityFile)
+		if err != nil {
+			return fmt.Errorf("failed to open identity file: %w", err)
+		}
+		identities, err = age.ParseIdentities(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("invalid identity file: %w", err)
+		}
+	} else {
+		passphrase, err := readPassphrase(*passphraseFile)
+		if err != nil {
+			return err
+		}
+		scryptIdentity, err := age.NewScryptIdentity(passphrase)
+		if err != nil {
+			return err
+		}
+		identities = []age.Identity{scryptIdentity}
+	}
+
+	file, err := os.Open(*input)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	snapshot, err := backup.Load(file, identities...)
+	file.Close()
+	if err != nil {
+		return err
+	}
+	target := *path
+	if target == "" {
+		target = snapshot.Root
+	}
+
+	vc, err := newVaultClient(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = vault_client.WithNamespace(ctx, *namespace)
+	results, err := backup.Restore(ctx, vc, snapshot, target, backup.RestoreOptions{Conflict: conflictPolicy, DryRun: *dryRun})
+	for _, result := range results {
+		fmt.Printf("%-9s %s\n", result.Action, result.Path)
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("Restore finished", "path", target, "secrets", len(results), "dry_run", *dryRun)
+	return nil
+}
+
+func newVaultClient(ctx context.Context) (*vault_client.VaultClient, error) {
+	cfg, err := config.LoadVaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	return vault_client.NewVaultClient(ctx, cfg)
+}
+
+// readPassphrase reads the passphrase from file or, without one, from VAULT_BACKUP_PASSPHRASE.
+func readPassphrase(file string) (string, error) {
+	passphrase := os.Getenv("VAULT_BACKUP_PASSPHRASE")
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read passphrase file: %w", err)
+		}
+		passphrase = strings.TrimRight(string(content), "\r\n")
+	}
+	if passphrase == "" {
+		return "", errors.New("a recipient, identity or passphrase is required (-passphrase-file or VAULT_BACKUP_PASSPHRASE)")
+	}
+	return passphrase, nil
+}
@@ -0,0 +1,185 @@
+// Package backup takes encrypted snapshots of a tree of KV secrets and restores them under another
+// path, possibly on another Vault cluster.
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"vault-gateway/internal/vault_client"
+
+	"filippo.io/age"
+)
+
+const snapshotVersion = 1
+
+// ErrConflict is returned by Restore with ConflictFail when secrets already exist at the target, and
+// with any policy when a KV v2 secret changes at the target while it is being restored.
+var ErrConflict = errors.New("secrets already exist at the restore target")
+
+// Snapshot is the decrypted content of a backup file. Secret paths are relative to Root, which is
+// the data path the backup was taken from.
+type Snapshot struct {
+	Version   int       `json:"version"`
+	Root      string    `json:"root"`
+	CreatedAt time.Time `json:"created_at"`
+	Secrets   []Secret  `json:"secrets"`
+}
+
+type Secret struct {
+	Path     string                 `json:"path"`
+	Data     map[string]interface{} `json:"data"`
+	Metadata *Metadata              `json:"metadata,omitempty"`
+}
+
+// Metadata holds the KV v2 settings kept in a backup. Version history is not kept: a restored
+// secret starts over at version 1 on its target.
+type Metadata struct {
+	CustomMetadata     map[string]string `json:"custom_metadata,omitempty"`
+	MaxVersions        int64             `json:"max_versions,omitempty"`
+	CasRequired        bool              `json:"cas_required,omitempty"`
+	DeleteVersionAfter string            `json:"delete_version_after,omitempty"`
+}
+
+// Create reads the latest version of every secret under root, together with its KV v2 metadata.
+// Unlike the WalkSecrets RPC, any folder or secret that cannot be read fails the whole backup, so
+// a snapshot is never silently incomplete. Secrets whose latest version is deleted are skipped.
+func Create(ctx context.Context, vc *vault_client.VaultClient, root string, concurrency int) (*Snapshot, error) {
+	root = strings.TrimSuffix(strings.TrimPrefix(root, "/"), "/") + "/"
+	rootDepth := strings.Count(root, "/")
+	snapshot := &Snapshot{Version: snapshotVersion, CreatedAt: time.Now().UTC()}
+
+	var mu sync.Mutex
+	err := vc.Walk(ctx, root, concurrency, func(path string, err error) error {
+		if err != nil {
+			return err
+		}
+		secret, err := readSecret(ctx, vc, path)
+		if err != nil || secret == nil {
+			return err
+		}
+		// Walk reports data paths, which have as many segments as root even when root was given as
+		// a metadata path.
+		segments := strings.SplitN(path, "/", rootDepth+1)
+		secret.Path = segments[rootDepth]
+
+		mu.Lock()
+		defer mu.Unlock()
+		if snapshot.Root == "" {
+			snapshot.Root = strings.Join(segments[:rootDepth], "/") + "/"
+		}
+		snapshot.Secrets = append(snapshot.Secrets, *secret)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Root == "" {
+		snapshot.Root = root
+	}
+	sort.Slice(snapshot.Secrets, func(i, j int) bool {
+		return snapshot.Secrets[i].Path < snapshot.Secrets[j].Path
+	})
+	return snapshot, nil
+}
+
+// readSecret reads one secret for a backup. It returns nil when the latest version of a KV v2
+// secret is deleted or destroyed.
+func readSecret(ctx context.Context, vc *vault_client.VaultClient, path string) (*Secret, error) {
+	vaultSecret, err := vc.ReadSecret(ctx, path, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, isKVv2 := vaultSecret.Data["metadata"].(map[string]interface{}); !isKVv2 {
+		return &Secret{Data: vaultSecret.Data}, nil
+	}
+	data, _ := vaultSecret.Data["data"].(map[string]interface{})
+	if data == nil {
+		slog.Warn("Skipping secret whose latest version is deleted", "path", path)
+		return nil, nil
+	}
+
+	metadataSecret, err := vc.ReadMetadata(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return &Secret{Data: data, Metadata: parseMetadata(metadataSecret.Data)}, nil
+}
+
+func parseMetadata(data map[string]interface{}) *Metadata {
+	metadata := &Metadata{}
+	if number, ok := data["max_versions"].(json.Number); ok {
+		metadata.MaxVersions, _ = number.Int64()
+	}
+	metadata.CasRequired, _ = data["cas_required"].(bool)
+	if deleteAfter, _ := data["delete_version_after"].(string); deleteAfter != "0s" {
+		metadata.DeleteVersionAfter = deleteAfter
+	}
+	if custom, ok := data["custom_metadata"].(map[string]interface{}); ok && len(custom) > 0 {
+		metadata.CustomMetadata = make(map[string]string, len(custom))
+		for key, value := range custom {
+			metadata.CustomMetadata[key] = fmt.Sprint(value)
+		}
+	}
+	return metadata
+}
+
+// ErrDecrypt is returned when none of the given identities opens a backup: it was encrypted to
+// another key or with another passphrase.
+var ErrDecrypt = errors.New("failed to decrypt backup: wrong key or passphrase")
+
+// Save encrypts the snapshot with age to recipient and writes it to w.
+func Save(w io.Writer, snapshot *Snapshot, recipient age.Recipient) error {
+	plaintext, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	encrypted, err := age.Encrypt(w, recipient)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt backup: %w", err)
+	}
+	if _, err := encrypted.Write(plaintext); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := encrypted.Close(); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+// Load decrypts a backup written by Save with the first identity that opens it. Numbers in secret
+// data are kept as json.Number so they are written back to Vault unchanged.
+func Load(r io.Reader, identities ...age.Identity) (*Snapshot, error) {
+	decrypted, err := age.Decrypt(r, identities...)
+	if err != nil {
+		var noMatch *age.NoIdentityMatchError
+		if errors.As(err, &noMatch) {
+			return nil, fmt.Errorf("%w: %v", ErrDecrypt, err)
+		}
+		return nil, fmt.Errorf("failed to decrypt backup: %w", err)
+	}
+	// Read the whole file first, so that a modified or truncated backup fails before any of it is
+	// decoded.
+	plaintext, err := io.ReadAll(decrypted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt backup: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(plaintext))
+	decoder.UseNumber()
+	var snapshot Snapshot
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if snapshot.Version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	return &snapshot, nil
+}
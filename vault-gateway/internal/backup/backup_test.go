@@ -0,0 +1,133 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+)
+
+// cheapPassphrase returns a scrypt recipient with a low work factor, to keep the tests fast.
+func cheapPassphrase(t *testing.T, passphrase string) *age.ScryptRecipient {
+	t.Helper()
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient.SetWorkFactor(10)
+	return recipient
+}
+
+func passphraseIdentity(t *testing.T, passphrase string) *age.ScryptIdentity {
+	t.Helper()
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return identity
+}
+
+func saveForTest(t *testing.T, snapshot *Snapshot, recipient age.Recipient) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Save(&buf, snapshot, recipient); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := &Snapshot{Version: snapshotVersion, Root: "kv/data/app/", Secrets: []Secret{{
+		Path:     "db",
+		Data:     map[string]interface{}{"password": "hunter2", "port": json.Number("5432"), "big": json.Number("12345678901234567890")},
+		Metadata: &Metadata{MaxVersions: 5, CustomMetadata: map[string]string{"owner": "team"}},
+	}}}
+
+	tests := []struct {
+		name      string
+		recipient age.Recipient
+		identity  age.Identity
+	}{
+		{name: "x25519", recipient: identity.Recipient(), identity: identity},
+		{name: "passphrase", recipient: cheapPassphrase(t, "correct horse"), identity: passphraseIdentity(t, "correct horse")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := saveForTest(t, snapshot, tt.recipient)
+			if bytes.Contains(file, []byte("hunter2")) {
+				t.Fatal("plaintext found in the backup file")
+			}
+			loaded, err := Load(bytes.NewReader(file), tt.identity)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			// Numbers are kept as json.Number so they are written back unchanged.
+			data := loaded.Secrets[0].Data
+			if data["port"] != json.Number("5432") || data["big"] != json.Number("12345678901234567890") {
+				t.Errorf("data = %#v, want the numbers unchanged", data)
+			}
+			if loaded.Root != "kv/data/app/" || loaded.Secrets[0].Metadata.MaxVersions != 5 {
+				t.Errorf("snapshot = %+v", loaded)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsWrongKeysAndModifiedFiles(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := &Snapshot{Version: snapshotVersion, Root: "kv/data/app/"}
+	x25519File := saveForTest(t, snapshot, identity.Recipient())
+	passphraseFile := saveForTest(t, snapshot, cheapPassphrase(t, "correct horse"))
+	modified := append([]byte{}, x25519File...)
+	modified[len(modified)-1] ^= 1
+
+	tests := []struct {
+		name     string
+		file     []byte
+		identity age.Identity
+		wantErr  error
+	}{
+		{name: "wrong identity", file: x25519File, identity: other, wantErr: ErrDecrypt},
+		{name: "wrong passphrase", file: passphraseFile, identity: passphraseIdentity(t, "wrong"), wantErr: ErrDecrypt},
+		{name: "passphrase for an x25519 file", file: x25519File, identity: passphraseIdentity(t, "correct horse"), wantErr: ErrDecrypt},
+		{name: "identity for a passphrase file", file: passphraseFile, identity: identity, wantErr: ErrDecrypt},
+		{name: "modified ciphertext", file: modified, identity: identity},
+		{name: "not a backup", file: []byte("hello\n"), identity: identity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaded, err := Load(bytes.NewReader(tt.file), tt.identity)
+			if err == nil {
+				t.Fatalf("Load succeeded with %+v", loaded)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsFutureVersions(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := saveForTest(t, &Snapshot{Version: snapshotVersion + 1}, identity.Recipient())
+	if _, err := Load(bytes.NewReader(file), identity); err == nil || !strings.Contains(err.Error(), "unsupported snapshot version") {
+		t.Errorf("Load of a future version: err = %v", err)
+	}
+}
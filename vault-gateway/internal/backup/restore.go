@@ -0,0 +1,192 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"vault-gateway/internal/vault_client"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// ConflictPolicy decides what Restore does with secrets that already exist at the target.
+type ConflictPolicy string
+
+const (
+	ConflictSkip      ConflictPolicy = "skip"
+	ConflictOverwrite ConflictPolicy = "overwrite"
+	ConflictFail      ConflictPolicy = "fail"
+)
+
+// ParseConflictPolicy validates a policy given on the command line.
+func ParseConflictPolicy(s string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(s); policy {
+	case ConflictSkip, ConflictOverwrite, ConflictFail:
+		return policy, nil
+	}
+	return "", fmt.Errorf("invalid conflict policy %q (expected %s, %s or %s)", s, ConflictSkip, ConflictOverwrite, ConflictFail)
+}
+
+// Actions reported for each secret of a restore.
+const (
+	ActionCreate    = "create"
+	ActionOverwrite = "overwrite"
+	ActionSkip      = "skip"
+)
+
+type RestoreOptions struct {
+	Conflict ConflictPolicy
+	// DryRun only reports what would be done, without writing to Vault.
+	DryRun bool
+}
+
+type RestoreResult struct {
+	Path   string
+	Action string
+}
+
+// RestoreClient is the part of the Vault client used by Restore.
+type RestoreClient interface {
+	ReadSecret(ctx context.Context, path string, version int32) (*api.Secret, error)
+	WriteSecret(ctx context.Context, path string, data map[string]interface{}) (*api.Secret, error)
+	WriteMetadata(ctx context.Context, path string, data map[string]interface{}) error
+}
+
+// Restore writes the secrets of the snapshot under target, a data path on KV v2 mounts. Existing
+// secrets are checked before anything is written, so ConflictFail leaves the target untouched.
+// KV v2 metadata is restored when the target is also KV v2; on KV v1 it is dropped with a warning.
+//
+// On KV v2 every write is a check-and-set against the version seen by that check, so a secret
+// created or changed by someone else during the restore fails it with ErrConflict instead of being
+// overwritten. KV v1 has no check-and-set, and there the check can race with concurrent writers.
+//
+// A restore is not atomic: secrets are written one at a time and the first failure stops it. The
+// secrets written until then stay in place; they are logged as they are written and returned with
+// the error, so a partial restore can be completed with ConflictSkip or cleaned up by hand.
+func Restore(ctx context.Context, vc RestoreClient, snapshot *Snapshot, target string, opts RestoreOptions) ([]RestoreResult, error) {
+	target = strings.TrimSuffix(strings.TrimPrefix(target, "/"), "/") + "/"
+
+	results := make([]RestoreResult, 0, len(snapshot.Secrets))
+	versions := make([]int64, 0, len(snapshot.Secrets))
+	var conflicts []string
+	for _, secret := range snapshot.Secrets {
+		if !validRelativePath(secret.Path) {
+			return nil, fmt.Errorf("%w: invalid secret path %q in snapshot", vault_client.ErrInvalidPath, secret.Path)
+		}
+		path := target + secret.Path
+		exists, version, err := currentVersion(ctx, vc, path)
+		if err != nil {
+			return nil, err
+		}
+		action := ActionCreate
+		if exists {
+			conflicts = append(conflicts, path)
+			action = ActionOverwrite
+			if opts.Conflict != ConflictOverwrite {
+				action = ActionSkip
+			}
+		}
+		results = append(results, RestoreResult{Path: path, Action: action})
+		versions = append(versions, version)
+	}
+	if opts.Conflict == ConflictFail && len(conflicts) > 0 {
+		return results, fmt.Errorf("%w: %s", ErrConflict, strings.Join(conflicts, ", "))
+	}
+	if opts.DryRun {
+		return results, nil
+	}
+
+	var written []RestoreResult
+	for i, secret := range snapshot.Secrets {
+		result := results[i]
+		if result.Action == ActionSkip {
+			continue
+		}
+		dataWritten, err := restoreSecret(ctx, vc, result.Path, secret, versions[i])
+		if dataWritten {
+			written = append(written, result)
+			slog.Info("Secret restored", "path", result.Path, "action", result.Action)
+		}
+		if err != nil {
+			return written, fmt.Errorf("restore stopped at %s with %d of %d secrets written: %w", result.Path, len(written), len(snapshot.Secrets), err)
+		}
+	}
+	return results, nil
+}
+
+// restoreSecret writes one secret and its metadata, reporting whether the data was written even
+// when the metadata then fails.
+func restoreSecret(ctx context.Context, vc RestoreClient, path string, secret Secret, version int64) (bool, error) {
+	body := map[string]interface{}{"data": secret.Data, "options": map[string]interface{}{"cas": version}}
+	_, err := vc.WriteSecret(ctx, path, body)
+	if errors.Is(err, vault_client.ErrNotKVv2) {
+		_, err = vc.WriteSecret(ctx, path, map[string]interface{}{"data": secret.Data})
+	}
+	if vault_client.IsCASMismatch(err) {
+		return false, fmt.Errorf("%w: %s was changed during the restore", ErrConflict, path)
+	}
+	if err != nil {
+		return false, err
+	}
+	if secret.Metadata != nil {
+		if err := vc.WriteMetadata(ctx, path, secret.Metadata.vaultData()); errors.Is(err, vault_client.ErrNotKVv2) {
+			slog.Warn("Target is not a KV v2 mount, metadata not restored", "path", path)
+		} else if err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// currentVersion reports whether the target already has a readable latest version and, on KV v2,
+// the current version number to check-and-set against. A secret whose latest version is deleted
+// does not exist for the restore but still has a version.
+func currentVersion(ctx context.Context, vc RestoreClient, path string) (bool, int64, error) {
+	secret, err := vc.ReadSecret(ctx, path, 0)
+	if errors.Is(err, vault_client.ErrNotFound) {
+		return false, 0, nil
+	}
+	if err != nil {
+		return false, 0, err
+	}
+	metadata, isKVv2 := secret.Data["metadata"].(map[string]interface{})
+	if !isKVv2 {
+		return true, 0, nil
+	}
+	number, _ := metadata["version"].(json.Number)
+	version, _ := number.Int64()
+	return secret.Data["data"] != nil, version, nil
+}
+
+func (m *Metadata) vaultData() map[string]interface{} {
+	customMetadata := map[string]interface{}{}
+	for key, value := range m.CustomMetadata {
+		customMetadata[key] = value
+	}
+	data := map[string]interface{}{
+		"custom_metadata": customMetadata,
+		"cas_required":    m.CasRequired,
+	}
+	if m.MaxVersions > 0 {
+		data["max_versions"] = m.MaxVersions
+	}
+	if m.DeleteVersionAfter != "" {
+		data["delete_version_after"] = m.DeleteVersionAfter
+	}
+	return data
+}
+
+func validRelativePath(path string) bool {
+	if path == "" || strings.HasSuffix(path, "/") {
+		return false
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,302 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"vault-gateway/internal/vault_client"
+
+	"github.com/hashicorp/vault/api"
+)
+
+type fakeSecret struct {
+	data    map[string]interface{}
+	version int64
+	deleted bool // latest version deleted: read returns no data, but the version still counts
+}
+
+// fakeKV is a KV mount for Restore. On KV v2 it enforces check-and-set like Vault; on KV v1 it
+// rejects check-and-set options like VaultClient.WriteSecret.
+type fakeKV struct {
+	kvV1     bool
+	secrets  map[string]*fakeSecret
+	writes   []string
+	metadata []string
+	failOn   string
+	// beforeWrite runs before each write, to simulate concurrent writers.
+	beforeWrite func(path string)
+}
+
+func newFakeKV(existing map[string]*fakeSecret) *fakeKV {
+	if existing == nil {
+		existing = map[string]*fakeSecret{}
+	}
+	return &fakeKV{secrets: existing}
+}
+
+func (kv *fakeKV) ReadSecret(_ context.Context, path string, _ int32) (*api.Secret, error) {
+	secret, ok := kv.secrets[path]
+	if !ok {
+		return nil, fmt.Errorf("%w: no data found at path %s", vault_client.ErrNotFound, path)
+	}
+	if kv.kvV1 {
+		return &api.Secret{Data: secret.data}, nil
+	}
+	var data interface{}
+	if !secret.deleted {
+		data = secret.data
+	}
+	return &api.Secret{Data: map[string]interface{}{
+		"data":     data,
+		"metadata": map[string]interface{}{"version": json.Number(fmt.Sprint(secret.version))},
+	}}, nil
+}
+
+func (kv *fakeKV) WriteSecret(_ context.Context, path string, body map[string]interface{}) (*api.Secret, error) {
+	if kv.beforeWrite != nil {
+		kv.beforeWrite(path)
+	}
+	if path == kv.failOn {
+		return nil, &api.ResponseError{StatusCode: http.StatusInternalServerError, Errors: []string{"storage failure"}}
+	}
+	options, hasOptions := body["options"].(map[string]interface{})
+	if kv.kvV1 && hasOptions {
+		return nil, fmt.Errorf("%w: check-and-set is not supported on kv/", vault_client.ErrNotKVv2)
+	}
+	current := kv.secrets[path]
+	if hasOptions {
+		var version int64
+		if current != nil {
+			version = current.version
+		}
+		if options["cas"] != version {
+			return nil, &api.ResponseError{StatusCode: http.StatusBadRequest, Errors: []string{"check-and-set parameter did not match the current version"}}
+		}
+	}
+	data, _ := body["data"].(map[string]interface{})
+	if current == nil {
+		current = &fakeSecret{}
+		kv.secrets[path] = current
+	}
+	current.data, current.deleted = data, false
+	current.version++
+	kv.writes = append(kv.writes, path)
+	return &api.Secret{}, nil
+}
+
+func (kv *fakeKV) WriteMetadata(_ context.Context, path string, _ map[string]interface{}) error {
+	if kv.kvV1 {
+		return fmt.Errorf("%w: kv/ is a KV v1 mount", vault_client.ErrNotKVv2)
+	}
+	kv.metadata = append(kv.metadata, path)
+	return nil
+}
+
+func testSnapshot() *Snapshot {
+	return &Snapshot{Version: snapshotVersion, Root: "kv/data/app/", Secrets: []Secret{
+		{Path: "a", Data: map[string]interface{}{"key": "a"}, Metadata: &Metadata{MaxVersions: 3}},
+		{Path: "b", Data: map[string]interface{}{"key": "b"}},
+		{Path: "nested/c", Data: map[string]interface{}{"key": "c"}},
+	}}
+}
+
+func existingB() map[string]*fakeSecret {
+	return map[string]*fakeSecret{"kv/data/copy/b": {data: map[string]interface{}{"key": "old"}, version: 4}}
+}
+
+func actions(results []RestoreResult) []string {
+	var out []string
+	for _, result := range results {
+		out = append(out, result.Action+" "+result.Path)
+	}
+	return out
+}
+
+func TestRestoreConflictPolicies(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        RestoreOptions
+		wantErr     error
+		wantActions []string
+		wantWrites  []string
+		wantB       string
+	}{
+		{
+			name:        "fail",
+			opts:        RestoreOptions{Conflict: ConflictFail},
+			wantErr:     ErrConflict,
+			wantActions: []string{"create kv/data/copy/a", "skip kv/data/copy/b", "create kv/data/copy/nested/c"},
+			wantB:       "old",
+		},
+		{
+			name:        "skip",
+			opts:        RestoreOptions{Conflict: ConflictSkip},
+			wantActions: []string{"create kv/data/copy/a", "skip kv/data/copy/b", "create kv/data/copy/nested/c"},
+			wantWrites:  []string{"kv/data/copy/a", "kv/data/copy/nested/c"},
+			wantB:       "old",
+		},
+		{
+			name:        "overwrite",
+			opts:        RestoreOptions{Conflict: ConflictOverwrite},
+			wantActions: []string{"create kv/data/copy/a", "overwrite kv/data/copy/b", "create kv/data/copy/nested/c"},
+			wantWrites:  []string{"kv/data/copy/a", "kv/data/copy/b", "kv/data/copy/nested/c"},
+			wantB:       "b",
+		},
+		{
+			name:        "dry run",
+			opts:        RestoreOptions{Conflict: ConflictOverwrite, DryRun: true},
+			wantActions: []string{"create kv/data/copy/a", "overwrite kv/data/copy/b", "create kv/data/copy/nested/c"},
+			wantB:       "old",
+		},
+		{
+			name:        "dry run with conflicts",
+			opts:        RestoreOptions{Conflict: ConflictFail, DryRun: true},
+			wantErr:     ErrConflict,
+			wantActions: []string{"create kv/data/copy/a", "skip kv/data/copy/b", "create kv/data/copy/nested/c"},
+			wantB:       "old",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV(existingB())
+			results, err := Restore(context.Background(), kv, testSnapshot(), "/kv/data/copy/", tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got := actions(results); !reflect.DeepEqual(got, tt.wantActions) {
+				t.Errorf("actions = %v, want %v", got, tt.wantActions)
+			}
+			if !reflect.DeepEqual(kv.writes, tt.wantWrites) {
+				t.Errorf("writes = %v, want %v", kv.writes, tt.wantWrites)
+			}
+			if got := kv.secrets["kv/data/copy/b"].data["key"]; got != tt.wantB {
+				t.Errorf("b = %v, want %v", got, tt.wantB)
+			}
+			if len(tt.wantWrites) > 0 && !reflect.DeepEqual(kv.metadata, []string{"kv/data/copy/a"}) {
+				t.Errorf("metadata writes = %v", kv.metadata)
+			}
+		})
+	}
+}
+
+func TestRestoreOverDeletedLatestVersion(t *testing.T) {
+	kv := newFakeKV(map[string]*fakeSecret{"kv/data/copy/b": {data: map[string]interface{}{"key": "old"}, version: 7, deleted: true}})
+	results, err := Restore(context.Background(), kv, testSnapshot(), "kv/data/copy", RestoreOptions{Conflict: ConflictFail})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if results[1].Action != ActionCreate {
+		t.Errorf("action for a deleted secret = %s, want create", results[1].Action)
+	}
+	if b := kv.secrets["kv/data/copy/b"]; b.data["key"] != "b" || b.version != 8 {
+		t.Errorf("b = %+v, want version 8 with the restored data", b)
+	}
+}
+
+func TestRestoreDetectsConcurrentWriters(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing map[string]*fakeSecret
+		conflict ConflictPolicy
+		racer    func(kv *fakeKV)
+	}{
+		{
+			name:     "secret created after the check",
+			conflict: ConflictFail,
+			racer: func(kv *fakeKV) {
+				kv.secrets["kv/data/copy/b"] = &fakeSecret{data: map[string]interface{}{"key": "theirs"}, version: 1}
+			},
+		},
+		{
+			name:     "secret changed after the check",
+			existing: existingB(),
+			conflict: ConflictOverwrite,
+			racer: func(kv *fakeKV) {
+				kv.secrets["kv/data/copy/b"].data = map[string]interface{}{"key": "theirs"}
+				kv.secrets["kv/data/copy/b"].version++
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV(tt.existing)
+			kv.beforeWrite = func(path string) {
+				if path == "kv/data/copy/b" {
+					tt.racer(kv)
+				}
+			}
+			results, err := Restore(context.Background(), kv, testSnapshot(), "kv/data/copy", RestoreOptions{Conflict: tt.conflict})
+			if !errors.Is(err, ErrConflict) {
+				t.Fatalf("err = %v, want ErrConflict", err)
+			}
+			if got := kv.secrets["kv/data/copy/b"].data["key"]; got != "theirs" {
+				t.Errorf("b = %v, the concurrent write was overwritten", got)
+			}
+			if got := actions(results); !reflect.DeepEqual(got, []string{"create kv/data/copy/a"}) {
+				t.Errorf("written = %v, want only a", got)
+			}
+		})
+	}
+}
+
+func TestRestorePartialFailure(t *testing.T) {
+	kv := newFakeKV(nil)
+	kv.failOn = "kv/data/copy/nested/c"
+	results, err := Restore(context.Background(), kv, testSnapshot(), "kv/data/copy", RestoreOptions{Conflict: ConflictFail})
+	if err == nil {
+		t.Fatal("Restore succeeded")
+	}
+	if !strings.Contains(err.Error(), "2 of 3 secrets written") {
+		t.Errorf("err = %v, want it to report the partial restore", err)
+	}
+	if got := actions(results); !reflect.DeepEqual(got, []string{"create kv/data/copy/a", "create kv/data/copy/b"}) {
+		t.Errorf("written = %v", got)
+	}
+}
+
+func TestRestoreKVv1(t *testing.T) {
+	kv := newFakeKV(map[string]*fakeSecret{"kv/copy/b": {data: map[string]interface{}{"key": "old"}}})
+	kv.kvV1 = true
+	results, err := Restore(context.Background(), kv, testSnapshot(), "kv/copy", RestoreOptions{Conflict: ConflictOverwrite})
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if got := actions(results); !reflect.DeepEqual(got, []string{"create kv/copy/a", "overwrite kv/copy/b", "create kv/copy/nested/c"}) {
+		t.Errorf("actions = %v", got)
+	}
+	if got := kv.secrets["kv/copy/b"].data["key"]; got != "b" {
+		t.Errorf("b = %v, want b", got)
+	}
+	if len(kv.metadata) != 0 {
+		t.Errorf("metadata writes on KV v1 = %v", kv.metadata)
+	}
+}
+
+func TestRestoreRejectsInvalidPaths(t *testing.T) {
+	for _, path := range []string{"", "../escape", "a//b", "folder/", "./a"} {
+		kv := newFakeKV(nil)
+		snapshot := &Snapshot{Version: snapshotVersion, Secrets: []Secret{{Path: path, Data: map[string]interface{}{"key": "x"}}}}
+		if _, err := Restore(context.Background(), kv, snapshot, "kv/data/copy", RestoreOptions{Conflict: ConflictOverwrite}); !errors.Is(err, vault_client.ErrInvalidPath) {
+			t.Errorf("path %q: err = %v, want ErrInvalidPath", path, err)
+		}
+		if len(kv.writes) != 0 {
+			t.Errorf("path %q: writes = %v", path, kv.writes)
+		}
+	}
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	for _, valid := range []string{"fail", "skip", "overwrite"} {
+		if policy, err := ParseConflictPolicy(valid); err != nil || string(policy) != valid {
+			t.Errorf("ParseConflictPolicy(%q) = %q, %v", valid, policy, err)
+		}
+	}
+	if _, err := ParseConflictPolicy("merge"); err == nil {
+		t.Error("ParseConflictPolicy accepted merge")
+	}
+}
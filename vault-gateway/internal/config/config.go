@@ -3,39 +3,128 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 )
 
+// Vault auth methods selectable with VAULT_AUTH_METHOD.
+const (
+	VaultAuthAppRole    = "approle"
+	VaultAuthKubernetes = "kubernetes"
+	VaultAuthJWT        = "jwt"
+	VaultAuthToken      = "token"
+)
+
 type Config struct {
-	VaultSrvAddr      string
-	VaultSrvRoleID    string
-	VaultSrvSecretID  string
-	VaultTimeout      time.Duration
-	VaultGtwAuthToken string
+	VaultSrvAddr            string
+	VaultAuthMethod         string
+	VaultAuthMount          string
+	VaultAuthRole           string
+	VaultAuthJWTFile        string
+	VaultTokenFile          string
+	VaultSrvRoleID          string
+	VaultSrvSecretID        string
+	VaultSrvSecretIDFile    string
+	VaultSrvSecretIDWrapped bool
+	VaultTimeout            time.Duration
+	VaultGtwAuthToken       string
+	VaultGtwAuthFile        string
+	VaultGtwAuthMethods     string
+	TLSEnabled              bool
+	TLSCAFile               string
+	TLSCertFile             string
+	TLSKeyFile              string
 }
 
 func LoadConfig() (*Config, error) {
+	cfg, err := LoadVaultConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.VaultGtwAuthToken = os.Getenv("INTERNAL_API_AUTH_TOKEN")
+	cfg.VaultGtwAuthFile = os.Getenv("INTERNAL_API_AUTH_TOKENS_FILE")
+	cfg.VaultGtwAuthMethods = os.Getenv("INTERNAL_API_AUTH_METHODS")
+
+	if cfg.VaultGtwAuthToken == "" && cfg.VaultGtwAuthFile == "" {
+		return nil, fmt.Errorf("INTERNAL_API_AUTH_TOKEN or INTERNAL_API_AUTH_TOKENS_FILE environment variable is not set")
+	}
+
+	cfg.TLSEnabled, _ = strconv.ParseBool(os.Getenv("GRPC_TLS_ENABLED"))
+	if cfg.TLSEnabled {
+		cfg.TLSCAFile = os.Getenv("GRPC_TLS_CA_FILE")
+		cfg.TLSCertFile = os.Getenv("GRPC_TLS_CERT_FILE")
+		cfg.TLSKeyFile = os.Getenv("GRPC_TLS_KEY_FILE")
+		if cfg.TLSCAFile == "" || cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, fmt.Errorf("GRPC_TLS_CA_FILE, GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE are required when GRPC_TLS_ENABLED is true")
+		}
+	}
+
+	return cfg, nil
+}
+
+// LoadVaultConfig loads only the settings needed to talk to Vault, for tools such as vault-backup
+// that use the Vault client without serving gRPC.
+func LoadVaultConfig() (*Config, error) {
 	cfg := &Config{
-		VaultSrvAddr:      os.Getenv("VAULT_SERVER_ADDR"),
-		VaultSrvRoleID:    os.Getenv("VAULT_SERVER_ROLE_ID"),
-		VaultSrvSecretID:  os.Getenv("VAULT_SERVER_SECRET_ID"),
-		VaultGtwAuthToken: os.Getenv("INTERNAL_API_AUTH_TOKEN"),
+		VaultSrvAddr:         os.Getenv("VAULT_SERVER_ADDR"),
+		VaultSrvRoleID:       os.Getenv("VAULT_SERVER_ROLE_ID"),
+		VaultSrvSecretID:     os.Getenv("VAULT_SERVER_SECRET_ID"),
+		VaultSrvSecretIDFile: os.Getenv("VAULT_SERVER_SECRET_ID_FILE"),
 	}
 
 	if cfg.VaultSrvAddr == "" {
 		return nil, fmt.Errorf("VAULT_SERVER_ADDR environment variable is not set")
 	}
-	if cfg.VaultSrvRoleID == "" {
-		return nil, fmt.Errorf("VAULT_SERVER_ROLE_ID environment variable is not set")
-	}
-	if cfg.VaultSrvSecretID == "" {
-		return nil, fmt.Errorf("VAULT_SERVER_SECRET_ID environment variable is not set")
-	}
-	if cfg.VaultGtwAuthToken == "" {
-		return nil, fmt.Errorf("INTERNAL_API_AUTH_TOKEN environment variable is not set")
+	if err := loadVaultAuthConfig(cfg); err != nil {
+		return nil, err
 	}
 
 	cfg.VaultTimeout = 30 * time.Second // Default timeout
 
 	return cfg, nil
 }
+
+// loadVaultAuthConfig reads the settings of the auth method the gateway uses to log in to Vault.
+// VAULT_AUTH_MOUNT overrides the default mount path of any method.
+func loadVaultAuthConfig(cfg *Config) error {
+	cfg.VaultAuthMethod = os.Getenv("VAULT_AUTH_METHOD")
+	if cfg.VaultAuthMethod == "" {
+		cfg.VaultAuthMethod = VaultAuthAppRole
+	}
+	cfg.VaultAuthMount = os.Getenv("VAULT_AUTH_MOUNT")
+
+	switch cfg.VaultAuthMethod {
+	case VaultAuthAppRole:
+		if cfg.VaultSrvRoleID == "" {
+			return fmt.Errorf("VAULT_SERVER_ROLE_ID environment variable is not set")
+		}
+		if cfg.VaultSrvSecretID == "" && cfg.VaultSrvSecretIDFile == "" {
+			return fmt.Errorf("VAULT_SERVER_SECRET_ID or VAULT_SERVER_SECRET_ID_FILE environment variable is not set")
+		}
+		if cfg.VaultSrvSecretID != "" && cfg.VaultSrvSecretIDFile != "" {
+			return fmt.Errorf("VAULT_SERVER_SECRET_ID and VAULT_SERVER_SECRET_ID_FILE are mutually exclusive")
+		}
+		// The secret ID file is re-read on every login. When VAULT_SERVER_SECRET_ID_WRAPPED is true the
+		// secret ID is a response-wrapping token; those can only be unwrapped once, so the unwrapped
+		// secret ID is reused for re-logins and the file is only read again once Vault rejects it.
+		cfg.VaultSrvSecretIDWrapped, _ = strconv.ParseBool(os.Getenv("VAULT_SERVER_SECRET_ID_WRAPPED"))
+	case VaultAuthKubernetes, VaultAuthJWT:
+		cfg.VaultAuthRole = os.Getenv("VAULT_AUTH_ROLE")
+		cfg.VaultAuthJWTFile = os.Getenv("VAULT_AUTH_JWT_FILE")
+		if cfg.VaultAuthRole == "" {
+			return fmt.Errorf("VAULT_AUTH_ROLE environment variable is required for the %s auth method", cfg.VaultAuthMethod)
+		}
+		// Kubernetes defaults to the pod's service account token.
+		if cfg.VaultAuthMethod == VaultAuthJWT && cfg.VaultAuthJWTFile == "" {
+			return fmt.Errorf("VAULT_AUTH_JWT_FILE environment variable is required for the jwt auth method")
+		}
+	case VaultAuthToken:
+		cfg.VaultTokenFile = os.Getenv("VAULT_TOKEN_FILE")
+		if cfg.VaultTokenFile == "" {
+			return fmt.Errorf("VAULT_TOKEN_FILE environment variable is required for the token auth method")
+		}
+	default:
+		return fmt.Errorf("invalid VAULT_AUTH_METHOD %q (expected %s, %s, %s or %s)", cfg.VaultAuthMethod, VaultAuthAppRole, VaultAuthKubernetes, VaultAuthJWT, VaultAuthToken)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadVaultAuthConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		cfg     Config
+		wantErr string
+		check   func(t *testing.T, cfg *Config)
+	}{
+		{
+			name: "approle by default",
+			cfg:  Config{VaultSrvRoleID: "role", VaultSrvSecretID: "secret"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.VaultAuthMethod != VaultAuthAppRole || cfg.VaultSrvSecretIDWrapped {
+					t.Errorf("method = %q, wrapped = %v", cfg.VaultAuthMethod, cfg.VaultSrvSecretIDWrapped)
+				}
+			},
+		},
+		{
+			name: "approle wrapped secret ID file",
+			env:  map[string]string{"VAULT_SERVER_SECRET_ID_WRAPPED": "true", "VAULT_AUTH_MOUNT": "approle-prod"},
+			cfg:  Config{VaultSrvRoleID: "role", VaultSrvSecretIDFile: "/run/secret-id"},
+			check: func(t *testing.T, cfg *Config) {
+				if !cfg.VaultSrvSecretIDWrapped || cfg.VaultAuthMount != "approle-prod" {
+					t.Errorf("wrapped = %v, mount = %q", cfg.VaultSrvSecretIDWrapped, cfg.VaultAuthMount)
+				}
+			},
+		},
+		{
+			name:    "approle without role ID",
+			cfg:     Config{VaultSrvSecretID: "secret"},
+			wantErr: "VAULT_SERVER_ROLE_ID",
+		},
+		{
+			name:    "approle without secret ID",
+			cfg:     Config{VaultSrvRoleID: "role"},
+			wantErr: "VAULT_SERVER_SECRET_ID or VAULT_SERVER_SECRET_ID_FILE",
+		},
+		{
+			name:    "approle with secret ID and file",
+			cfg:     Config{VaultSrvRoleID: "role", VaultSrvSecretID: "secret", VaultSrvSecretIDFile: "/run/secret-id"},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name: "kubernetes without token file",
+			env:  map[string]string{"VAULT_AUTH_METHOD": "kubernetes", "VAULT_AUTH_ROLE": "gateway"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.VaultAuthRole != "gateway" || cfg.VaultAuthJWTFile != "" {
+					t.Errorf("role = %q, jwt file = %q", cfg.VaultAuthRole, cfg.VaultAuthJWTFile)
+				}
+			},
+		},
+		{
+			name:    "kubernetes without role",
+			env:     map[string]string{"VAULT_AUTH_METHOD": "kubernetes"},
+			wantErr: "VAULT_AUTH_ROLE environment variable is required for the kubernetes auth method",
+		},
+		{
+			name: "jwt",
+			env:  map[string]string{"VAULT_AUTH_METHOD": "jwt", "VAULT_AUTH_ROLE": "gateway", "VAULT_AUTH_JWT_FILE": "/run/jwt"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.VaultAuthJWTFile != "/run/jwt" {
+					t.Errorf("jwt file = %q", cfg.VaultAuthJWTFile)
+				}
+			},
+		},
+		{
+			name:    "jwt without role",
+			env:     map[string]string{"VAULT_AUTH_METHOD": "jwt", "VAULT_AUTH_JWT_FILE": "/run/jwt"},
+			wantErr: "VAULT_AUTH_ROLE environment variable is required for the jwt auth method",
+		},
+		{
+			name:    "jwt without token file",
+			env:     map[string]string{"VAULT_AUTH_METHOD": "jwt", "VAULT_AUTH_ROLE": "gateway"},
+			wantErr: "VAULT_AUTH_JWT_FILE",
+		},
+		{
+			name: "token",
+			env:  map[string]string{"VAULT_AUTH_METHOD": "token", "VAULT_TOKEN_FILE": "/run/token"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.VaultTokenFile != "/run/token" {
+					t.Errorf("token file = %q", cfg.VaultTokenFile)
+				}
+			},
+		},
+		{
+			name:    "token without file",
+			env:     map[string]string{"VAULT_AUTH_METHOD": "token"},
+			wantErr: "VAULT_TOKEN_FILE",
+		},
+		{
+			name:    "unknown method",
+			env:     map[string]string{"VAULT_AUTH_METHOD": "userpass"},
+			wantErr: `invalid VAULT_AUTH_METHOD "userpass"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"VAULT_AUTH_METHOD", "VAULT_AUTH_MOUNT", "VAULT_AUTH_ROLE", "VAULT_AUTH_JWT_FILE", "VAULT_TOKEN_FILE", "VAULT_SERVER_SECRET_ID_WRAPPED"} {
+				t.Setenv(name, tt.env[name])
+			}
+			cfg := tt.cfg
+			err := loadVaultAuthConfig(&cfg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadVaultAuthConfig: %v", err)
+			}
+			tt.check(t, &cfg)
+		})
+	}
+}
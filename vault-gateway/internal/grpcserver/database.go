@@ -0,0 +1,65 @@
+package grpcserver
+
+import (
+	"context"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultDatabaseMount = "database"
+
+// GenerateDatabaseCredentials issues short-lived credentials from a database secrets engine role.
+// The caller is responsible for renewing or revoking the returned lease.
+func (s *Server) GenerateDatabaseCredentials(ctx context.Context, req *vault.GenerateDatabaseCredentialsRequest) (*vault.GenerateDatabaseCredentialsResponse, error) {
+	if req.GetRole() == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	mount := req.GetMount()
+	if mount == "" {
+		mount = defaultDatabaseMount
+	}
+
+	secret, err := s.vaultClient.GenerateDatabaseCredentials(ctx, mount, req.GetRole())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.GenerateDatabaseCredentialsResponse{
+		RequestId:     secret.RequestID,
+		LeaseId:       secret.LeaseID,
+		Renewable:     secret.Renewable,
+		LeaseDuration: int32(secret.LeaseDuration),
+		Username:      toString(secret.Data["username"]),
+		Password:      toString(secret.Data["password"]),
+	}, nil
+}
+
+func (s *Server) RenewLease(ctx context.Context, req *vault.RenewLeaseRequest) (*vault.RenewLeaseResponse, error) {
+	if req.GetLeaseId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_id is required")
+	}
+	if req.GetIncrement() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid increment %d", req.GetIncrement())
+	}
+
+	secret, err := s.vaultClient.RenewLease(ctx, req.GetLeaseId(), int(req.GetIncrement()))
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.RenewLeaseResponse{
+		LeaseId:       secret.LeaseID,
+		Renewable:     secret.Renewable,
+		LeaseDuration: int32(secret.LeaseDuration),
+	}, nil
+}
+
+func (s *Server) RevokeLease(ctx context.Context, req *vault.RevokeLeaseRequest) (*vault.RevokeLeaseResponse, error) {
+	if req.GetLeaseId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "lease_id is required")
+	}
+	if err := s.vaultClient.RevokeLease(ctx, req.GetLeaseId()); err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.RevokeLeaseResponse{Success: true}, nil
+}
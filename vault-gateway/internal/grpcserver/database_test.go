@@ -0,0 +1,151 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeDatabase is a database secrets engine mounted at "database/" and "db-prod/" with a role
+// "app"; "readonly" is denied by policy. Leases can be renewed and revoked through sys/leases.
+type fakeDatabase struct {
+	paths  []string
+	bodies []map[string]interface{}
+}
+
+func newDatabaseServer(t *testing.T) (*Server, *fakeDatabase) {
+	t.Helper()
+	fd := &fakeDatabase{}
+	return newVaultTestServer(t, fd), fd
+}
+
+func (fd *fakeDatabase) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fd.paths = append(fd.paths, r.Method+" "+r.URL.Path)
+	if r.Method == http.MethodPut {
+		var body map[string]interface{}
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		decoder.Decode(&body)
+		fd.bodies = append(fd.bodies, body)
+	}
+	switch r.URL.Path {
+	case "/v1/database/creds/app", "/v1/db-prod/creds/app":
+		mount, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{
+			"request_id":     "req-1",
+			"lease_id":       mount + "/creds/app/abc",
+			"renewable":      true,
+			"lease_duration": 3600,
+			"data":           map[string]interface{}{"username": "v-app-abc", "password": "secret"},
+		})
+	case "/v1/database/creds/readonly":
+		writeVaultJSON(w, http.StatusForbidden, map[string][]string{"errors": {"permission denied"}})
+	case "/v1/sys/leases/renew":
+		if body := fd.bodies[len(fd.bodies)-1]; body["lease_id"] != "database/creds/app/abc" {
+			writeVaultJSON(w, http.StatusBadRequest, map[string][]string{"errors": {"lease not found"}})
+			return
+		}
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"lease_id": "database/creds/app/abc", "renewable": true, "lease_duration": 1800})
+	case "/v1/sys/leases/revoke":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeVaultJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+	}
+}
+
+func TestGenerateDatabaseCredentials(t *testing.T) {
+	s, fd := newDatabaseServer(t)
+	response, err := s.GenerateDatabaseCredentials(context.Background(), &vault.GenerateDatabaseCredentialsRequest{Role: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &vault.GenerateDatabaseCredentialsResponse{
+		RequestId:     "req-1",
+		LeaseId:       "database/creds/app/abc",
+		Renewable:     true,
+		LeaseDuration: 3600,
+		Username:      "v-app-abc",
+		Password:      "secret",
+	}
+	if !proto.Equal(response, want) {
+		t.Errorf("response = %v, want %v", response, want)
+	}
+
+	response, err = s.GenerateDatabaseCredentials(context.Background(), &vault.GenerateDatabaseCredentialsRequest{Mount: "db-prod", Role: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.GetLeaseId() != "db-prod/creds/app/abc" {
+		t.Errorf("lease_id = %q, want the lease of the db-prod mount", response.GetLeaseId())
+	}
+	if want := []string{"GET /v1/database/creds/app", "GET /v1/db-prod/creds/app"}; strings.Join(fd.paths, ",") != strings.Join(want, ",") {
+		t.Errorf("requests = %v, want %v", fd.paths, want)
+	}
+}
+
+func TestGenerateDatabaseCredentialsErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *vault.GenerateDatabaseCredentialsRequest
+		wantCode codes.Code
+		wantSent bool
+	}{
+		{name: "no role", request: &vault.GenerateDatabaseCredentialsRequest{}, wantCode: codes.InvalidArgument},
+		{name: "role with slash", request: &vault.GenerateDatabaseCredentialsRequest{Role: "../app"}, wantCode: codes.InvalidArgument},
+		{name: "mount with dot segment", request: &vault.GenerateDatabaseCredentialsRequest{Mount: "database/..", Role: "app"}, wantCode: codes.InvalidArgument},
+		{name: "denied role", request: &vault.GenerateDatabaseCredentialsRequest{Role: "readonly"}, wantCode: codes.PermissionDenied, wantSent: true},
+		{name: "unknown role", request: &vault.GenerateDatabaseCredentialsRequest{Role: "missing"}, wantCode: codes.NotFound, wantSent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, fd := newDatabaseServer(t)
+			_, err := s.GenerateDatabaseCredentials(context.Background(), tt.request)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if sent := len(fd.paths) > 0; sent != tt.wantSent {
+				t.Errorf("request sent to Vault = %v, want %v", sent, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestRenewAndRevokeLease(t *testing.T) {
+	s, fd := newDatabaseServer(t)
+	renewed, err := s.RenewLease(context.Background(), &vault.RenewLeaseRequest{LeaseId: "database/creds/app/abc", Increment: 1800})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.GetLeaseDuration() != 1800 || !renewed.GetRenewable() {
+		t.Errorf("renewed = %v, want a renewable lease of 1800s", renewed)
+	}
+	if increment := fd.bodies[0]["increment"]; increment != json.Number("1800") {
+		t.Errorf("increment = %v, want 1800", increment)
+	}
+
+	_, err = s.RenewLease(context.Background(), &vault.RenewLeaseRequest{LeaseId: "database/creds/app/other"})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("unknown lease: code = %v (%v), want InvalidArgument", code, err)
+	}
+	_, err = s.RenewLease(context.Background(), &vault.RenewLeaseRequest{LeaseId: "database/creds/app/abc", Increment: -1})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("negative increment: code = %v (%v), want InvalidArgument", code, err)
+	}
+
+	if _, err := s.RevokeLease(context.Background(), &vault.RevokeLeaseRequest{LeaseId: "database/creds/app/abc"}); err != nil {
+		t.Fatal(err)
+	}
+	if last := fd.bodies[len(fd.bodies)-1]; last["lease_id"] != "database/creds/app/abc" {
+		t.Errorf("revoke body = %v, want the lease id", last)
+	}
+	if _, err := s.RevokeLease(context.Background(), &vault.RevokeLeaseRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("empty lease id: err = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,79 @@
+package grpcserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"vault-gateway/internal/vault_client"
+
+	"github.com/hashicorp/vault/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toInt32 reads a numeric value decoded by the Vault client, which uses json.Number.
+func toInt32(value interface{}) int32 {
+	return int32(toInt64(value))
+}
+
+func toInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case json.Number:
+		n, _ := v.Int64()
+		return n
+	case float64:
+		return int64(v)
+	case int:
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+func toString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// vaultError maps Vault client errors to gRPC status codes so the API can tell client
+// errors apart from gateway failures.
+func vaultError(err error) error {
+	if errors.Is(err, vault_client.ErrInvalidPath) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, vault_client.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, vault_client.ErrNotKVv2) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	var respErr *api.ResponseError
+	if errors.As(err, &respErr) {
+		// Only Vault's own error list is forwarded; the full error also carries the Vault URL.
+		message := strings.Join(respErr.Errors, "; ")
+		if message == "" {
+			message = http.StatusText(respErr.StatusCode)
+		}
+		if vault_client.IsCASMismatch(err) {
+			return status.Error(codes.FailedPrecondition, message)
+		}
+		switch respErr.StatusCode {
+		case http.StatusBadRequest:
+			return status.Error(codes.InvalidArgument, message)
+		case http.StatusForbidden:
+			return status.Error(codes.PermissionDenied, message)
+		case http.StatusNotFound:
+			return status.Error(codes.NotFound, message)
+		case http.StatusServiceUnavailable:
+			return status.Error(codes.Unavailable, message)
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+	"vault-gateway/internal/vault_client"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NamespaceMetadataKey carries the Vault Enterprise namespace a request should be sent to.
+const NamespaceMetadataKey = "x-vault-namespace"
+
+// NamespaceUnaryInterceptor moves the namespace from the request metadata into the context used by
+// the Vault client.
+func NamespaceUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := namespaceContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func NamespaceStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := namespaceContext(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &namespaceStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type namespaceStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *namespaceStream) Context() context.Context {
+	return s.ctx
+}
+
+func namespaceContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(NamespaceMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+	namespace := strings.Trim(values[0], "/")
+	for _, segment := range strings.Split(namespace, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid Vault namespace %q", values[0])
+		}
+	}
+	return vault_client.WithNamespace(ctx, namespace), nil
+}
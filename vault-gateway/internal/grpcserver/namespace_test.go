@@ -0,0 +1,67 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNamespaceInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     []string
+		wantNamespace string
+		wantCode      codes.Code
+	}{
+		{name: "no namespace"},
+		{name: "empty namespace", namespace: []string{""}},
+		{name: "namespace", namespace: []string{"team-a"}, wantNamespace: "team-a"},
+		{name: "nested namespace", namespace: []string{"/team-a/child/"}, wantNamespace: "team-a/child"},
+		{name: "first value wins", namespace: []string{"team-a", "team-b"}, wantNamespace: "team-a"},
+		{name: "empty segment", namespace: []string{"team-a//child"}, wantCode: codes.InvalidArgument},
+		{name: "dot segment", namespace: []string{"team-a/."}, wantCode: codes.InvalidArgument},
+		{name: "parent segment", namespace: []string{"team-a/../admin"}, wantCode: codes.InvalidArgument},
+		{name: "only slashes", namespace: []string{"//"}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var namespaces []string
+			s := newVaultTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				namespaces = append(namespaces, r.Header.Get("X-Vault-Namespace"))
+				writeVaultJSON(w, http.StatusOK, map[string]interface{}{
+					"lease_id": "database/creds/app/abc",
+					"data":     map[string]interface{}{"username": "v-app-abc", "password": "secret"},
+				})
+			}))
+
+			ctx := context.Background()
+			if tt.namespace != nil {
+				md := metadata.MD{}
+				md.Append(NamespaceMetadataKey, tt.namespace...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return s.GenerateDatabaseCredentials(ctx, req.(*vault.GenerateDatabaseCredentialsRequest))
+			}
+			_, err := NamespaceUnaryInterceptor()(ctx, &vault.GenerateDatabaseCredentialsRequest{Role: "app"}, &grpc.UnaryServerInfo{}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				if len(namespaces) != 0 {
+					t.Errorf("request sent to Vault for an invalid namespace")
+				}
+				return
+			}
+			if len(namespaces) != 1 || namespaces[0] != tt.wantNamespace {
+				t.Errorf("X-Vault-Namespace = %q, want %q", namespaces, tt.wantNamespace)
+			}
+		})
+	}
+}
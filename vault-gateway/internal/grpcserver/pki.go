@@ -0,0 +1,114 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultPKIMount = "pki"
+
+// IssueCertificate issues a certificate from a PKI role. The role in Vault decides which names and
+// TTLs are allowed; the gateway only forwards the request.
+func (s *Server) IssueCertificate(ctx context.Context, req *vault.IssueCertificateRequest) (*vault.IssueCertificateResponse, error) {
+	if req.GetRole() == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	if req.GetCommonName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "common_name is required")
+	}
+	data := map[string]interface{}{"common_name": req.GetCommonName()}
+	if len(req.GetAltNames()) > 0 {
+		data["alt_names"] = strings.Join(req.GetAltNames(), ",")
+	}
+	if len(req.GetIpSans()) > 0 {
+		data["ip_sans"] = strings.Join(req.GetIpSans(), ",")
+	}
+	if len(req.GetUriSans()) > 0 {
+		data["uri_sans"] = strings.Join(req.GetUriSans(), ",")
+	}
+	if req.GetTtl() != "" {
+		data["ttl"] = req.GetTtl()
+	}
+
+	secret, err := s.vaultClient.IssueCertificate(ctx, pkiMount(req.GetMount()), req.GetRole(), data)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	response := &vault.IssueCertificateResponse{
+		Certificate:    toString(secret.Data["certificate"]),
+		IssuingCa:      toString(secret.Data["issuing_ca"]),
+		PrivateKey:     toString(secret.Data["private_key"]),
+		PrivateKeyType: toString(secret.Data["private_key_type"]),
+		SerialNumber:   toString(secret.Data["serial_number"]),
+		Expiration:     toInt64(secret.Data["expiration"]),
+	}
+	if chain, ok := secret.Data["ca_chain"].([]interface{}); ok {
+		for _, certificate := range chain {
+			response.CaChain = append(response.CaChain, toString(certificate))
+		}
+	}
+	return response, nil
+}
+
+// RevokeCertificate revokes a certificate issued by the given role. The certificate is read by its
+// serial number and must be one the role could have issued; otherwise the request is denied, so that
+// access granted on one role cannot be used to revoke certificates of another. The role check is
+// best-effort (see pkiRole.allowsCertificate) and denies what it cannot evaluate.
+func (s *Server) RevokeCertificate(ctx context.Context, req *vault.RevokeCertificateRequest) (*vault.RevokeCertificateResponse, error) {
+	if req.GetSerialNumber() == "" {
+		return nil, status.Error(codes.InvalidArgument, "serial_number is required")
+	}
+	if req.GetRole() == "" {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	mount := pkiMount(req.GetMount())
+
+	certificatePEM, err := s.vaultClient.ReadPKICertificate(ctx, mount, req.GetSerialNumber())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	certificate, err := parseCertificatePEM(certificatePEM)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse certificate %s: %v", req.GetSerialNumber(), err)
+	}
+	roleData, err := s.vaultClient.ReadPKIRole(ctx, mount, req.GetRole())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	if err := parsePKIRole(roleData).allowsCertificate(certificate); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "certificate %s does not belong to role %s: %v", req.GetSerialNumber(), req.GetRole(), err)
+	}
+
+	secret, err := s.vaultClient.RevokeCertificate(ctx, mount, req.GetSerialNumber())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.RevokeCertificateResponse{RevocationTime: toInt64(secret.Data["revocation_time"])}, nil
+}
+
+func (s *Server) GetCACertificate(ctx context.Context, req *vault.GetCACertificateRequest) (*vault.GetCACertificateResponse, error) {
+	certificate, err := s.vaultClient.ReadPKICertificate(ctx, pkiMount(req.GetMount()), "ca")
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.GetCACertificateResponse{Certificate: certificate}, nil
+}
+
+func (s *Server) GetCRL(ctx context.Context, req *vault.GetCRLRequest) (*vault.GetCRLResponse, error) {
+	crl, err := s.vaultClient.ReadPKICertificate(ctx, pkiMount(req.GetMount()), "crl")
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.GetCRLResponse{Crl: crl}, nil
+}
+
+func pkiMount(mount string) string {
+	if mount == "" {
+		return defaultPKIMount
+	}
+	return mount
+}
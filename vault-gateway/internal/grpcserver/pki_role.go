@@ -0,0 +1,230 @@
+package grpcserver
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// pkiRole holds the name constraints of a Vault PKI role that decide which certificates it can issue.
+type pkiRole struct {
+	allowAnyName         bool
+	allowLocalhost       bool
+	allowBareDomains     bool
+	allowSubdomains      bool
+	allowGlobDomains     bool
+	allowWildcards       bool
+	allowIPSANs          bool
+	enforceHostnames     bool
+	validateCommonName   bool
+	allowedDomains       []string
+	allowedURISANs       []string
+	allowedOtherSANs     []string
+	allowedSerialNumbers []string
+}
+
+// parsePKIRole reads the role as returned by Vault, applying Vault's defaults for missing fields.
+func parsePKIRole(data map[string]interface{}) pkiRole {
+	role := pkiRole{
+		allowAnyName:         toBool(data["allow_any_name"], false),
+		allowLocalhost:       toBool(data["allow_localhost"], true),
+		allowBareDomains:     toBool(data["allow_bare_domains"], false),
+		allowSubdomains:      toBool(data["allow_subdomains"], false),
+		allowGlobDomains:     toBool(data["allow_glob_domains"], false),
+		allowWildcards:       toBool(data["allow_wildcard_certificates"], true),
+		allowIPSANs:          toBool(data["allow_ip_sans"], true),
+		enforceHostnames:     toBool(data["enforce_hostnames"], true),
+		validateCommonName:   true,
+		allowedURISANs:       toStringList(data["allowed_uri_sans"]),
+		allowedOtherSANs:     toStringList(data["allowed_other_sans"]),
+		allowedSerialNumbers: toStringList(data["allowed_serial_numbers"]),
+	}
+	// Templated domains depend on the identity that issued the certificate and cannot be evaluated
+	// here, so they never match.
+	for _, domain := range toStringList(data["allowed_domains"]) {
+		if !strings.Contains(domain, "{{") {
+			role.allowedDomains = append(role.allowedDomains, strings.ToLower(domain))
+		}
+	}
+	for _, validation := range toStringList(data["cn_validations"]) {
+		if validation == "disabled" {
+			role.validateCommonName = false
+		}
+	}
+	return role
+}
+
+// allowsCertificate reports whether the role could have issued the certificate. Vault does not
+// record the role that issued a certificate, so this is how a revocation is tied to a role: a caller
+// may revoke the certificates that the role it is authorized on could have issued.
+//
+// The check is best-effort: it follows Vault's name matching for the constraints above but not every
+// detail of it (IDNA names, templated domains). Where it cannot evaluate a constraint it denies, so
+// other-name SANs are only accepted by roles with allowed_other_sans "*".
+func (role pkiRole) allowsCertificate(cert *x509.Certificate) error {
+	var names []string
+	if role.validateCommonName && cert.Subject.CommonName != "" {
+		names = append(names, cert.Subject.CommonName)
+	}
+	names = append(names, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+	for _, name := range names {
+		if !role.allowsName(name) {
+			return fmt.Errorf("name %q is not allowed by the role", name)
+		}
+	}
+	if len(cert.IPAddresses) > 0 && !role.allowIPSANs {
+		return errors.New("IP SANs are not allowed by the role")
+	}
+	for _, uri := range cert.URIs {
+		if !matchesAnyGlob(role.allowedURISANs, uri.String()) {
+			return fmt.Errorf("URI SAN %q is not allowed by the role", uri)
+		}
+	}
+	if hasOtherNameSANs(cert) && !slices.Contains(role.allowedOtherSANs, "*") {
+		return errors.New("other-name SANs are only accepted for roles with allowed_other_sans \"*\"")
+	}
+	if serial := cert.Subject.SerialNumber; serial != "" && !matchesAnyGlob(role.allowedSerialNumbers, serial) {
+		return fmt.Errorf("subject serial number %q is not allowed by the role", serial)
+	}
+	return nil
+}
+
+// allowsName follows the order of Vault's checks: the hostname and wildcard rules apply even to roles
+// with allow_any_name, and glob matching only uses allowed domains that contain a "*".
+func (role pkiRole) allowsName(name string) bool {
+	name = strings.ToLower(name)
+	// E-mail addresses are checked by their domain, as Vault does.
+	if at := strings.LastIndex(name, "@"); at >= 0 {
+		name = name[at+1:]
+	}
+	wildcard := strings.HasPrefix(name, "*.")
+	if wildcard {
+		if !role.allowWildcards {
+			return false
+		}
+		name = name[2:]
+	}
+	if role.enforceHostnames && !hostnamePattern.MatchString(name) {
+		return false
+	}
+	if role.allowAnyName {
+		return true
+	}
+	if role.allowLocalhost && (name == "localhost" || name == "localdomain") {
+		return true
+	}
+	for _, domain := range role.allowedDomains {
+		if role.allowBareDomains && name == domain {
+			return true
+		}
+		// A wildcard for the domain itself covers its subdomains.
+		if role.allowSubdomains && (strings.HasSuffix(name, "."+domain) || (wildcard && name == domain)) {
+			return true
+		}
+		if role.allowGlobDomains && strings.Contains(domain, "*") && globMatch(domain, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostnamePattern is the hostname check Vault applies when enforce_hostnames is set.
+var hostnamePattern = regexp.MustCompile(`^(([a-z0-9]|[a-z0-9][a-z0-9\-]*[a-z0-9])\.)*([a-z0-9]|[a-z0-9][a-z0-9\-]*[a-z0-9])$`)
+
+var oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// hasOtherNameSANs reports whether the subject alternative names include an otherName, which
+// crypto/x509 does not parse. An extension that cannot be read counts as having one.
+func hasOtherNameSANs(cert *x509.Certificate) bool {
+	for _, extension := range cert.Extensions {
+		if !extension.Id.Equal(oidSubjectAltName) {
+			continue
+		}
+		var names asn1.RawValue
+		if _, err := asn1.Unmarshal(extension.Value, &names); err != nil {
+			return true
+		}
+		for rest := names.Bytes; len(rest) > 0; {
+			var name asn1.RawValue
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+				return true
+			}
+			if name.Class == asn1.ClassContextSpecific && name.Tag == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesAnyGlob matches value against patterns where "*" stands for any sequence of characters.
+func matchesAnyGlob(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if globMatch(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func globMatch(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+func parseCertificatePEM(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func toBool(value interface{}, fallback bool) bool {
+	if b, ok := value.(bool); ok {
+		return b
+	}
+	return fallback
+}
+
+// toStringList reads a list field from Vault, which may also come as a comma-separated string.
+func toStringList(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := toString(item); s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	case string:
+		if v == "" {
+			return nil
+		}
+		return strings.Split(v, ",")
+	default:
+		return nil
+	}
+}
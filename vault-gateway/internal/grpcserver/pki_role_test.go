@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestPKIRoleAllowsCertificate(t *testing.T) {
+	webRole := map[string]interface{}{
+		"allowed_domains":  []interface{}{"example.com"},
+		"allow_subdomains": true,
+		"allow_localhost":  false,
+		"allow_ip_sans":    false,
+		"ttl":              json.Number("3600"),
+	}
+	tests := []struct {
+		name    string
+		role    map[string]interface{}
+		cert    *x509.Certificate
+		allowed bool
+	}{
+		{name: "subdomain", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "app.example.com"}, DNSNames: []string{"app.example.com", "api.app.example.com"}}, allowed: true},
+		{name: "case insensitive", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "App.Example.COM"}}, allowed: true},
+		{name: "wildcard subdomain", role: webRole, cert: &x509.Certificate{DNSNames: []string{"*.example.com"}}, allowed: true},
+		{name: "bare domain not allowed", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "example.com"}}},
+		{name: "other domain", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "app.example.com"}, DNSNames: []string{"db.internal.net"}}},
+		{name: "suffix without dot", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "evilexample.com"}}},
+		{name: "localhost not allowed", role: webRole, cert: &x509.Certificate{DNSNames: []string{"localhost"}}},
+		{name: "IP SAN not allowed", role: webRole, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "app.example.com"}, IPAddresses: []net.IP{net.ParseIP("10.0.0.1")}}},
+		{name: "bare domain", role: map[string]interface{}{"allowed_domains": "example.com", "allow_bare_domains": true}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "example.com"}, IPAddresses: []net.IP{net.ParseIP("10.0.0.1")}}, allowed: true},
+		{name: "glob domain", role: map[string]interface{}{"allowed_domains": []interface{}{"app-*.example.com"}, "allow_glob_domains": true}, cert: &x509.Certificate{DNSNames: []string{"app-01.example.com"}}, allowed: true},
+		{name: "glob mismatch", role: map[string]interface{}{"allowed_domains": []interface{}{"app-*.example.com"}, "allow_glob_domains": true}, cert: &x509.Certificate{DNSNames: []string{"db-01.example.com"}}},
+		{name: "wildcards disabled", role: map[string]interface{}{"allowed_domains": []interface{}{"example.com"}, "allow_subdomains": true, "allow_wildcard_certificates": false}, cert: &x509.Certificate{DNSNames: []string{"*.example.com"}}},
+		{name: "any name", role: map[string]interface{}{"allow_any_name": true}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "anything.test"}}, allowed: true},
+		{name: "templated domain never matches", role: map[string]interface{}{"allowed_domains": []interface{}{"{{identity.entity.name}}.example.com"}, "allow_bare_domains": true}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "alice.example.com"}}},
+		{name: "common name validation disabled", role: map[string]interface{}{"allowed_domains": []interface{}{"example.com"}, "allow_subdomains": true, "cn_validations": []interface{}{"disabled"}}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "Service Account"}, DNSNames: []string{"svc.example.com"}}, allowed: true},
+		{name: "email by domain", role: webRole, cert: &x509.Certificate{EmailAddresses: []string{"ops@mail.example.com"}}, allowed: true},
+		{name: "URI SAN allowed", role: map[string]interface{}{"allow_any_name": true, "allowed_uri_sans": []interface{}{"spiffe://example.com/*"}}, cert: &x509.Certificate{URIs: []*url.URL{{Scheme: "spiffe", Host: "example.com", Path: "/web"}}}, allowed: true},
+		{name: "glob across labels", role: map[string]interface{}{"allowed_domains": []interface{}{"*.example.com"}, "allow_glob_domains": true}, cert: &x509.Certificate{DNSNames: []string{"a.b.example.com"}}, allowed: true},
+		{name: "glob without star is not a bare domain", role: map[string]interface{}{"allowed_domains": []interface{}{"example.com"}, "allow_glob_domains": true}, cert: &x509.Certificate{DNSNames: []string{"example.com"}}},
+		{name: "wildcard of a glob match", role: map[string]interface{}{"allowed_domains": []interface{}{"app-*.example.com"}, "allow_glob_domains": true}, cert: &x509.Certificate{DNSNames: []string{"*.app-01.example.com"}}, allowed: true},
+		{name: "bare domain without subdomains", role: map[string]interface{}{"allowed_domains": "example.com", "allow_bare_domains": true}, cert: &x509.Certificate{DNSNames: []string{"app.example.com"}}},
+		{name: "bare domain and subdomains", role: map[string]interface{}{"allowed_domains": "example.com", "allow_bare_domains": true, "allow_subdomains": true}, cert: &x509.Certificate{DNSNames: []string{"example.com", "app.example.com"}}, allowed: true},
+		{name: "wildcard of the allowed domain", role: webRole, cert: &x509.Certificate{DNSNames: []string{"*.example.com"}, Subject: pkix.Name{CommonName: "*.example.com"}}, allowed: true},
+		{name: "hostname enforced", role: webRole, cert: &x509.Certificate{DNSNames: []string{"bad_name.example.com"}}},
+		{name: "hostname enforced with any name", role: map[string]interface{}{"allow_any_name": true}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "Service Account"}}},
+		{name: "hostname not enforced", role: map[string]interface{}{"allowed_domains": "example.com", "allow_subdomains": true, "enforce_hostnames": false}, cert: &x509.Certificate{DNSNames: []string{"bad_name.example.com"}}, allowed: true},
+		{name: "localdomain", role: map[string]interface{}{}, cert: &x509.Certificate{DNSNames: []string{"localhost", "localdomain"}}, allowed: true},
+		{name: "serial number allowed", role: map[string]interface{}{"allow_any_name": true, "allowed_serial_numbers": []interface{}{"dev-*"}}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "app.test", SerialNumber: "dev-42"}}, allowed: true},
+		{name: "serial number not allowed", role: map[string]interface{}{"allow_any_name": true}, cert: &x509.Certificate{Subject: pkix.Name{CommonName: "app.test", SerialNumber: "dev-42"}}},
+		{name: "other name not checked", role: map[string]interface{}{"allow_any_name": true, "allowed_other_sans": []interface{}{"1.3.6.1.4.1.311.20.2.3;UTF8:*"}}, cert: &x509.Certificate{Extensions: []pkix.Extension{otherNameSAN(t)}}},
+		{name: "other name with any allowed", role: map[string]interface{}{"allow_any_name": true, "allowed_other_sans": []interface{}{"*"}}, cert: &x509.Certificate{Extensions: []pkix.Extension{otherNameSAN(t)}}, allowed: true},
+		{name: "URI SAN not allowed", role: map[string]interface{}{"allow_any_name": true}, cert: &x509.Certificate{URIs: []*url.URL{{Scheme: "spiffe", Host: "example.com", Path: "/web"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parsePKIRole(tt.role).allowsCertificate(tt.cert)
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Errorf("allowsCertificate = %v, want allowed %v", err, tt.allowed)
+			}
+		})
+	}
+}
+
+// otherNameSAN builds a subject alternative name extension with a DNS name and an otherName.
+func otherNameSAN(t *testing.T) pkix.Extension {
+	t.Helper()
+	value, err := asn1.Marshal("alice@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oid, err := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	explicit, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherName := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(oid, explicit...)}
+	dnsName := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte("app.test")}
+	names, err := asn1.Marshal([]asn1.RawValue{dnsName, otherName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkix.Extension{Id: oidSubjectAltName, Value: names}
+}
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		want           bool
+	}{
+		{"example.com", "example.com", true},
+		{"*.example.com", "a.b.example.com", true},
+		{"app-*", "app-01", true},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "aXcYb", false},
+		{"ab*ba", "aba", false},
+		{"*", "", true},
+	}
+	for _, tt := range tests {
+		if got := globMatch(tt.pattern, tt.value); got != tt.want {
+			t.Errorf("globMatch(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
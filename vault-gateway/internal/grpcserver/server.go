@@ -3,9 +3,13 @@ package grpcserver
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"vault-gateway/internal/vault_client"
 	"vault-gateway/proto/vault"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -21,9 +25,12 @@ func NewServer(vaultClient *vault_client.VaultClient) *Server {
 }
 
 func (s *Server) ReadSecret(ctx context.Context, req *vault.ReadSecretRequest) (*vault.ReadSecretResponse, error) {
-	vaultSecret, err := s.vaultClient.ReadSecret(ctx, req.GetPath())
+	if req.GetVersion() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid version %d", req.GetVersion())
+	}
+	vaultSecret, err := s.vaultClient.ReadSecret(ctx, req.GetPath(), req.GetVersion())
 	if err != nil {
-		return nil, err
+		return nil, vaultError(err)
 	}
 
 	dataStruct, err := structpb.NewStruct(vaultSecret.Data)
@@ -32,30 +39,63 @@ func (s *Server) ReadSecret(ctx context.Context, req *vault.ReadSecretRequest) (
 	}
 
 	return &vault.ReadSecretResponse{
-		RequestId:     vaultSecret.RequestID,
-		LeaseId:       vaultSecret.LeaseID,
-		Renewable:     vaultSecret.Renewable,
-		LeaseDuration: int32(vaultSecret.LeaseDuration),
-		Data:          dataStruct,
+		RequestId:       vaultSecret.RequestID,
+		LeaseId:         vaultSecret.LeaseID,
+		Renewable:       vaultSecret.Renewable,
+		LeaseDuration:   int32(vaultSecret.LeaseDuration),
+		Data:            dataStruct,
+		VersionMetadata: versionMetadata(vaultSecret.Data["metadata"]),
 	}, nil
 }
 
+// WriteSecret writes the secret at path. When cas is set, KV v2 only accepts the write if the
+// current version matches it (0 means the secret must not exist yet).
 func (s *Server) WriteSecret(ctx context.Context, req *vault.WriteSecretRequest) (*vault.WriteSecretResponse, error) {
 	dataMap := req.GetData().AsMap()
+	if req.Cas != nil {
+		if req.GetCas() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cas %d", req.GetCas())
+		}
+		dataMap["options"] = map[string]interface{}{"cas": req.GetCas()}
+	}
 
-	_, err := s.vaultClient.WriteSecret(ctx, req.GetPath(), dataMap)
+	secret, err := s.vaultClient.WriteSecret(ctx, req.GetPath(), dataMap)
 	if err != nil {
-		return nil, err
+		return nil, vaultError(err)
 	}
 	return &vault.WriteSecretResponse{
 		Success: true,
+		Version: toInt32(secret.Data["version"]),
+	}, nil
+}
+
+// PatchSecret applies a JSON merge patch to a KV v2 secret in a single Vault request, so concurrent
+// patches to different keys do not overwrite each other.
+func (s *Server) PatchSecret(ctx context.Context, req *vault.PatchSecretRequest) (*vault.PatchSecretResponse, error) {
+	if req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+	if len(req.GetData().GetFields()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "patch data is required")
+	}
+	if req.Cas != nil && req.GetCas() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cas %d", req.GetCas())
+	}
+
+	secret, err := s.vaultClient.PatchSecret(ctx, req.GetPath(), req.GetData().AsMap(), req.Cas)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.PatchSecretResponse{
+		Success: true,
+		Version: toInt32(secret.Data["version"]),
 	}, nil
 }
 
 func (s *Server) ListSecrets(ctx context.Context, req *vault.ListSecretsRequest) (*vault.ListSecretsResponse, error) {
 	secret, err := s.vaultClient.List(ctx, req.GetPath())
 	if err != nil {
-		return nil, err
+		return nil, vaultError(err)
 	}
 
 	keysData, ok := secret.Data["keys"]
@@ -77,3 +117,117 @@ func (s *Server) ListSecrets(ctx context.Context, req *vault.ListSecretsRequest)
 
 	return &vault.ListSecretsResponse{Keys: keys}, nil
 }
+
+// DeleteSecret soft-deletes the latest version of a KV v2 secret, or the listed versions when given.
+func (s *Server) DeleteSecret(ctx context.Context, req *vault.DeleteSecretRequest) (*vault.DeleteSecretResponse, error) {
+	if req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+	var err error
+	if len(req.GetVersions()) == 0 {
+		err = s.vaultClient.Delete(ctx, req.GetPath())
+	} else {
+		err = s.vaultClient.DeleteVersions(ctx, req.GetPath(), req.GetVersions())
+	}
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.DeleteSecretResponse{Success: true}, nil
+}
+
+func (s *Server) UndeleteSecret(ctx context.Context, req *vault.UndeleteSecretRequest) (*vault.UndeleteSecretResponse, error) {
+	if err := validateVersionsRequest(req.GetPath(), req.GetVersions()); err != nil {
+		return nil, err
+	}
+	if err := s.vaultClient.Undelete(ctx, req.GetPath(), req.GetVersions()); err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.UndeleteSecretResponse{Success: true}, nil
+}
+
+func (s *Server) DestroySecretVersions(ctx context.Context, req *vault.DestroySecretVersionsRequest) (*vault.DestroySecretVersionsResponse, error) {
+	if err := validateVersionsRequest(req.GetPath(), req.GetVersions()); err != nil {
+		return nil, err
+	}
+	if err := s.vaultClient.Destroy(ctx, req.GetPath(), req.GetVersions()); err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.DestroySecretVersionsResponse{Success: true}, nil
+}
+
+// GetSecretMetadata returns the version history and settings of a KV v2 secret.
+func (s *Server) GetSecretMetadata(ctx context.Context, req *vault.GetSecretMetadataRequest) (*vault.GetSecretMetadataResponse, error) {
+	if req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+	secret, err := s.vaultClient.ReadMetadata(ctx, req.GetPath())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+
+	data := secret.Data
+	response := &vault.GetSecretMetadataResponse{
+		CurrentVersion:     toInt32(data["current_version"]),
+		OldestVersion:      toInt32(data["oldest_version"]),
+		MaxVersions:        toInt32(data["max_versions"]),
+		CreatedTime:        toString(data["created_time"]),
+		UpdatedTime:        toString(data["updated_time"]),
+		DeleteVersionAfter: toString(data["delete_version_after"]),
+		CustomMetadata:     map[string]string{},
+	}
+	response.CasRequired, _ = data["cas_required"].(bool)
+	if custom, ok := data["custom_metadata"].(map[string]interface{}); ok {
+		for key, value := range custom {
+			response.CustomMetadata[key] = toString(value)
+		}
+	}
+	if versions, ok := data["versions"].(map[string]interface{}); ok {
+		for key, value := range versions {
+			metadata := versionMetadata(value)
+			if metadata == nil {
+				continue
+			}
+			version, err := strconv.ParseInt(key, 10, 32)
+			if err != nil {
+				continue
+			}
+			metadata.Version = int32(version)
+			response.Versions = append(response.Versions, metadata)
+		}
+	}
+	sort.Slice(response.Versions, func(i, j int) bool {
+		return response.Versions[i].Version < response.Versions[j].Version
+	})
+	return response, nil
+}
+
+// versionMetadata converts the metadata block Vault returns for a KV v2 version. It returns nil
+// for secrets that are not from a KV v2 engine.
+func versionMetadata(value interface{}) *vault.SecretVersionMetadata {
+	metadata, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := &vault.SecretVersionMetadata{
+		Version:      toInt32(metadata["version"]),
+		CreatedTime:  toString(metadata["created_time"]),
+		DeletionTime: toString(metadata["deletion_time"]),
+	}
+	result.Destroyed, _ = metadata["destroyed"].(bool)
+	return result
+}
+
+func validateVersionsRequest(path string, versions []int32) error {
+	if path == "" {
+		return status.Error(codes.InvalidArgument, "path is required")
+	}
+	if len(versions) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one version is required")
+	}
+	for _, version := range versions {
+		if version <= 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid version %d", version)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"vault-gateway/internal/config"
+	"vault-gateway/internal/vault_client"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// newVaultTestServer returns a gateway server whose Vault client talks to handler, logged in with a
+// static token. Token lookups are answered here, with a token that needs no renewal.
+func newVaultTestServer(t *testing.T, handler http.Handler) *Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/token/lookup-self" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"ttl": 0, "renewable": false}})
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "s.test" {
+			t.Errorf("request without the Vault token: %s", r.URL.Path)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("s.test\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &config.Config{VaultSrvAddr: server.URL, VaultAuthMethod: config.VaultAuthToken, VaultTokenFile: tokenFile}
+	vc, err := vault_client.NewVaultClient(t.Context(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewServer(vc)
+}
+
+// fakeKV is a Vault with a KV v2 mount at "secret/" and a KV v1 mount at "kv/". Each KV v2 secret
+// keeps the data of every version; a nil entry is a soft-deleted version. Request bodies and the
+// paths of KV v1 requests are recorded.
+type fakeKV struct {
+	mu      sync.Mutex
+	secrets map[string][]map[string]interface{}
+	bodies  []map[string]interface{}
+	kv1     []string
+}
+
+func newKVServer(t *testing.T) (*Server, *fakeKV) {
+	t.Helper()
+	kv := &fakeKV{secrets: map[string][]map[string]interface{}{
+		"app": {{"password": "v1"}, nil, {"password": "v3", "user": "app"}},
+	}}
+	return newVaultTestServer(t, kv), kv
+}
+
+func (kv *fakeKV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if r.URL.Path == "/v1/sys/mounts" {
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{
+			"secret/": map[string]interface{}{"type": "kv", "options": map[string]string{"version": "2"}},
+			"kv/":     map[string]interface{}{"type": "kv", "options": map[string]string{"version": "1"}},
+		}})
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, "/v1/kv/") {
+		kv.kv1 = append(kv.kv1, r.Method+" "+r.URL.Path)
+		writeVaultJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+		return
+	}
+	section, name, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/secret/"), "/")
+	versions, exists := kv.secrets[name]
+	switch {
+	case r.Method == http.MethodGet && section == "data":
+		version := len(versions)
+		if value := r.URL.Query().Get("version"); value != "" {
+			version, _ = strconv.Atoi(value)
+		}
+		if !exists || version < 1 || version > len(versions) || versions[version-1] == nil {
+			writeVaultJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+			return
+		}
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{
+			"data":     versions[version-1],
+			"metadata": map[string]interface{}{"version": version, "created_time": "2026-01-01T00:00:00Z", "destroyed": false},
+		}})
+	case r.Method == http.MethodGet && section == "metadata":
+		if !exists {
+			writeVaultJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+			return
+		}
+		versionMetadata := map[string]interface{}{}
+		for i, data := range versions {
+			metadata := map[string]interface{}{"created_time": "2026-01-01T00:00:00Z", "deletion_time": "", "destroyed": false}
+			if data == nil {
+				metadata["deletion_time"] = "2026-01-02T00:00:00Z"
+			}
+			versionMetadata[strconv.Itoa(i+1)] = metadata
+		}
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{
+			"current_version": len(versions),
+			"oldest_version":  1,
+			"max_versions":    0,
+			"custom_metadata": map[string]interface{}{"owner": "infra"},
+			"versions":        versionMetadata,
+		}})
+	case (r.Method == http.MethodPut || r.Method == http.MethodPost || r.Method == http.MethodPatch) && section == "data":
+		var body map[string]interface{}
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		decoder.Decode(&body)
+		kv.bodies = append(kv.bodies, body)
+		data, _ := body["data"].(map[string]interface{})
+		if r.Method == http.MethodPatch {
+			if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+				writeVaultJSON(w, http.StatusUnsupportedMediaType, map[string][]string{"errors": {"unsupported content type"}})
+				return
+			}
+			if !exists || versions[len(versions)-1] == nil {
+				writeVaultJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+				return
+			}
+			data = mergePatch(versions[len(versions)-1], data)
+		}
+		if !kv.casMatches(body, len(versions)) {
+			writeVaultJSON(w, http.StatusBadRequest, map[string][]string{"errors": {"check-and-set parameter did not match the current version"}})
+			return
+		}
+		kv.secrets[name] = append(versions, data)
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": len(versions) + 1}})
+	default:
+		writeVaultJSON(w, http.StatusMethodNotAllowed, map[string][]string{"errors": {"unsupported operation"}})
+	}
+}
+
+// casMatches checks the options.cas of a write against the current version, like KV v2.
+func (kv *fakeKV) casMatches(body map[string]interface{}, current int) bool {
+	options, _ := body["options"].(map[string]interface{})
+	cas, ok := options["cas"].(json.Number)
+	if !ok {
+		return true
+	}
+	return cas.String() == strconv.Itoa(current)
+}
+
+// mergePatch applies a JSON merge patch to the top-level keys of a secret.
+func mergePatch(current, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(current))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+func writeVaultJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestReadSecretVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      int32
+		wantCode     codes.Code
+		wantPassword string
+		wantVersion  int32
+	}{
+		{name: "latest", wantPassword: "v3", wantVersion: 3},
+		{name: "specific version", version: 1, wantPassword: "v1", wantVersion: 1},
+		{name: "deleted version", version: 2, wantCode: codes.NotFound},
+		{name: "missing version", version: 9, wantCode: codes.NotFound},
+		{name: "negative version", version: -1, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newKVServer(t)
+			response, err := s.ReadSecret(context.Background(), &vault.ReadSecretRequest{Path: "secret/data/app", Version: tt.version})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			data, _ := response.GetData().AsMap()["data"].(map[string]interface{})
+			if data["password"] != tt.wantPassword {
+				t.Errorf("data = %v, want password %s", data, tt.wantPassword)
+			}
+			if got := response.GetVersionMetadata().GetVersion(); got != tt.wantVersion {
+				t.Errorf("version metadata = %v, want version %d", response.GetVersionMetadata(), tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestGetSecretMetadata(t *testing.T) {
+	s, _ := newKVServer(t)
+	response, err := s.GetSecretMetadata(context.Background(), &vault.GetSecretMetadataRequest{Path: "secret/data/app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.GetCurrentVersion() != 3 || response.GetCustomMetadata()["owner"] != "infra" {
+		t.Errorf("metadata = %v, want current version 3 and the custom metadata", response)
+	}
+	var versions []int32
+	for _, version := range response.GetVersions() {
+		versions = append(versions, version.GetVersion())
+	}
+	if len(versions) != 3 || versions[0] != 1 || versions[2] != 3 {
+		t.Errorf("versions = %v, want 1, 2, 3 in order", versions)
+	}
+	if response.GetVersions()[1].GetDeletionTime() == "" {
+		t.Errorf("version 2 = %v, want a deletion time", response.GetVersions()[1])
+	}
+
+	_, err = s.GetSecretMetadata(context.Background(), &vault.GetSecretMetadataRequest{Path: "secret/data/missing"})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("missing secret: code = %v (%v), want NotFound", code, err)
+	}
+	_, err = s.GetSecretMetadata(context.Background(), &vault.GetSecretMetadataRequest{Path: "secret/app"})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("path outside data/: code = %v (%v), want InvalidArgument", code, err)
+	}
+}
+
+func TestWriteSecretCAS(t *testing.T) {
+	tests := []struct {
+		name        string
+		cas         *int32
+		wantCode    codes.Code
+		wantOptions interface{}
+		wantVersion int32
+	}{
+		{name: "without cas", wantVersion: 4},
+		{name: "matching cas", cas: ptr(int32(3)), wantOptions: map[string]interface{}{"cas": json.Number("3")}, wantVersion: 4},
+		{name: "stale cas", cas: ptr(int32(2)), wantOptions: map[string]interface{}{"cas": json.Number("2")}, wantCode: codes.FailedPrecondition},
+		{name: "negative cas", cas: ptr(int32(-1)), wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, kv := newKVServer(t)
+			data, err := structpb.NewStruct(map[string]interface{}{"data": map[string]interface{}{"password": "v4"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			response, err := s.WriteSecret(context.Background(), &vault.WriteSecretRequest{Path: "secret/data/app", Data: data, Cas: tt.cas})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if response.GetVersion() != tt.wantVersion {
+				t.Errorf("version = %d, want %d", response.GetVersion(), tt.wantVersion)
+			}
+			if tt.wantCode == codes.InvalidArgument {
+				if len(kv.bodies) != 0 {
+					t.Errorf("write sent to Vault with an invalid cas")
+				}
+				return
+			}
+			if len(kv.bodies) != 1 || !reflect.DeepEqual(kv.bodies[0]["options"], tt.wantOptions) {
+				t.Errorf("bodies = %v, want options %v", kv.bodies, tt.wantOptions)
+			}
+		})
+	}
+}
+
+func TestPatchSecret(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		patch       map[string]interface{}
+		cas         *int32
+		wantCode    codes.Code
+		wantData    map[string]interface{}
+		wantVersion int32
+	}{
+		{name: "null deletes a key", patch: map[string]interface{}{"user": nil, "host": "db"}, wantData: map[string]interface{}{"password": "v3", "host": "db"}, wantVersion: 4},
+		{name: "matching cas", patch: map[string]interface{}{"password": "v4"}, cas: ptr(int32(3)), wantData: map[string]interface{}{"password": "v4", "user": "app"}, wantVersion: 4},
+		{name: "stale cas", patch: map[string]interface{}{"password": "v4"}, cas: ptr(int32(1)), wantCode: codes.FailedPrecondition},
+		{name: "missing secret", path: "secret/data/missing", patch: map[string]interface{}{"password": "v4"}, wantCode: codes.NotFound},
+		{name: "KV v1 mount", path: "kv/app", patch: map[string]interface{}{"password": "v4"}, wantCode: codes.FailedPrecondition},
+		{name: "metadata path", path: "secret/metadata/app", patch: map[string]interface{}{"password": "v4"}, wantCode: codes.InvalidArgument},
+		{name: "empty patch", wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, kv := newKVServer(t)
+			path := tt.path
+			if path == "" {
+				path = "secret/data/app"
+			}
+			data, err := structpb.NewStruct(tt.patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response, err := s.PatchSecret(context.Background(), &vault.PatchSecretRequest{Path: path, Data: data, Cas: tt.cas})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if len(kv.kv1) != 0 {
+				t.Errorf("KV v1 requests = %v, want none", kv.kv1)
+			}
+			if err != nil {
+				return
+			}
+			if response.GetVersion() != tt.wantVersion {
+				t.Errorf("version = %d, want %d", response.GetVersion(), tt.wantVersion)
+			}
+			versions := kv.secrets["app"]
+			if got := versions[len(versions)-1]; !reflect.DeepEqual(got, tt.wantData) {
+				t.Errorf("data = %v, want %v", got, tt.wantData)
+			}
+		})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
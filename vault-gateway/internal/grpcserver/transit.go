@@ -0,0 +1,196 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultTransitMount = "transit"
+
+// Encrypt encrypts each item with the named transit key. Plaintexts never leave the request; the
+// key itself stays in Vault.
+func (s *Server) Encrypt(ctx context.Context, req *vault.EncryptRequest) (*vault.EncryptResponse, error) {
+	if err := validateTransitRequest(req.GetKey(), len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	batch := make([]map[string]interface{}, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		batch = append(batch, transitItem("plaintext", base64.StdEncoding.EncodeToString(item.GetPlaintext()), item.GetContext()))
+	}
+	body := map[string]interface{}{"batch_input": batch}
+	if req.GetKeyVersion() > 0 {
+		body["key_version"] = req.GetKeyVersion()
+	}
+
+	results, err := s.vaultClient.Transit(ctx, transitMount(req.GetMount()), "encrypt", req.GetKey(), body)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.EncryptResponse{Results: ciphertextResults(results)}, nil
+}
+
+func (s *Server) Decrypt(ctx context.Context, req *vault.DecryptRequest) (*vault.DecryptResponse, error) {
+	if err := validateTransitRequest(req.GetKey(), len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	batch := make([]map[string]interface{}, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		batch = append(batch, transitItem("ciphertext", item.GetCiphertext(), item.GetContext()))
+	}
+
+	results, err := s.vaultClient.Transit(ctx, transitMount(req.GetMount()), "decrypt", req.GetKey(), map[string]interface{}{"batch_input": batch})
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	response := &vault.DecryptResponse{}
+	for _, value := range results {
+		result, _ := value.(map[string]interface{})
+		item := &vault.TransitPlaintextResult{Error: toString(result["error"])}
+		if item.Error == "" {
+			plaintext, err := base64.StdEncoding.DecodeString(toString(result["plaintext"]))
+			if err != nil {
+				item.Error = "invalid plaintext returned by Vault"
+			} else {
+				item.Plaintext = plaintext
+			}
+		}
+		response.Results = append(response.Results, item)
+	}
+	return response, nil
+}
+
+// Rewrap re-encrypts ciphertexts with the latest (or the given) version of the key without
+// exposing the plaintext, so old key versions can be retired.
+func (s *Server) Rewrap(ctx context.Context, req *vault.RewrapRequest) (*vault.RewrapResponse, error) {
+	if err := validateTransitRequest(req.GetKey(), len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	batch := make([]map[string]interface{}, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		batch = append(batch, transitItem("ciphertext", item.GetCiphertext(), item.GetContext()))
+	}
+	body := map[string]interface{}{"batch_input": batch}
+	if req.GetKeyVersion() > 0 {
+		body["key_version"] = req.GetKeyVersion()
+	}
+
+	results, err := s.vaultClient.Transit(ctx, transitMount(req.GetMount()), "rewrap", req.GetKey(), body)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.RewrapResponse{Results: ciphertextResults(results)}, nil
+}
+
+func (s *Server) Sign(ctx context.Context, req *vault.SignRequest) (*vault.SignResponse, error) {
+	if err := validateTransitRequest(req.GetKey(), len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	batch := make([]map[string]interface{}, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		batch = append(batch, transitItem("input", base64.StdEncoding.EncodeToString(item.GetInput()), item.GetContext()))
+	}
+	body := signatureOptions(req.GetHashAlgorithm(), req.GetSignatureAlgorithm(), req.GetPrehashed())
+	body["batch_input"] = batch
+	if req.GetKeyVersion() > 0 {
+		body["key_version"] = req.GetKeyVersion()
+	}
+
+	results, err := s.vaultClient.Transit(ctx, transitMount(req.GetMount()), "sign", req.GetKey(), body)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	response := &vault.SignResponse{}
+	for _, value := range results {
+		result, _ := value.(map[string]interface{})
+		response.Results = append(response.Results, &vault.TransitSignatureResult{
+			Signature:  toString(result["signature"]),
+			KeyVersion: toInt32(result["key_version"]),
+			Error:      toString(result["error"]),
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) Verify(ctx context.Context, req *vault.VerifyRequest) (*vault.VerifyResponse, error) {
+	if err := validateTransitRequest(req.GetKey(), len(req.GetItems())); err != nil {
+		return nil, err
+	}
+	batch := make([]map[string]interface{}, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		entry := transitItem("input", base64.StdEncoding.EncodeToString(item.GetInput()), item.GetContext())
+		entry["signature"] = item.GetSignature()
+		batch = append(batch, entry)
+	}
+	body := signatureOptions(req.GetHashAlgorithm(), req.GetSignatureAlgorithm(), req.GetPrehashed())
+	body["batch_input"] = batch
+
+	results, err := s.vaultClient.Transit(ctx, transitMount(req.GetMount()), "verify", req.GetKey(), body)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	response := &vault.VerifyResponse{}
+	for _, value := range results {
+		result, _ := value.(map[string]interface{})
+		item := &vault.TransitVerifyResult{Error: toString(result["error"])}
+		item.Valid, _ = result["valid"].(bool)
+		response.Results = append(response.Results, item)
+	}
+	return response, nil
+}
+
+func validateTransitRequest(key string, items int) error {
+	if key == "" {
+		return status.Error(codes.InvalidArgument, "key is required")
+	}
+	if items == 0 {
+		return status.Error(codes.InvalidArgument, "at least one item is required")
+	}
+	return nil
+}
+
+func transitMount(mount string) string {
+	if mount == "" {
+		return defaultTransitMount
+	}
+	return mount
+}
+
+// transitItem builds one batch_input entry. Vault expects the derivation context base64 encoded.
+func transitItem(field, value string, derivationContext []byte) map[string]interface{} {
+	item := map[string]interface{}{field: value}
+	if len(derivationContext) > 0 {
+		item["context"] = base64.StdEncoding.EncodeToString(derivationContext)
+	}
+	return item
+}
+
+func signatureOptions(hashAlgorithm, signatureAlgorithm string, prehashed bool) map[string]interface{} {
+	options := map[string]interface{}{}
+	if hashAlgorithm != "" {
+		options["hash_algorithm"] = hashAlgorithm
+	}
+	if signatureAlgorithm != "" {
+		options["signature_algorithm"] = signatureAlgorithm
+	}
+	if prehashed {
+		options["prehashed"] = true
+	}
+	return options
+}
+
+func ciphertextResults(results []interface{}) []*vault.TransitCiphertextResult {
+	converted := make([]*vault.TransitCiphertextResult, 0, len(results))
+	for _, value := range results {
+		result, _ := value.(map[string]interface{})
+		converted = append(converted, &vault.TransitCiphertextResult{
+			Ciphertext: toString(result["ciphertext"]),
+			KeyVersion: toInt32(result["key_version"]),
+			Error:      toString(result["error"]),
+		})
+	}
+	return converted
+}
@@ -0,0 +1,182 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeTransit is a transit engine with a single key, "app". Ciphertexts are the plaintext with a
+// "vault:v1:" prefix. Like Vault, a batch with failed items answers 400 unless the request sets
+// partial_failure_response_code.
+type fakeTransit struct {
+	t      *testing.T
+	bodies []map[string]interface{}
+}
+
+func newTransitServer(t *testing.T) (*Server, *fakeTransit) {
+	t.Helper()
+	ft := &fakeTransit{t: t}
+	return newVaultTestServer(t, ft), ft
+}
+
+func (ft *fakeTransit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var body map[string]interface{}
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		ft.t.Errorf("invalid request body: %v", err)
+	}
+	ft.bodies = append(ft.bodies, body)
+
+	operation, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/transit/"), "/")
+	if key != "app" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {"encryption key not found"}})
+		return
+	}
+
+	var results []map[string]interface{}
+	failed := false
+	batch, _ := body["batch_input"].([]interface{})
+	for _, value := range batch {
+		item, _ := value.(map[string]interface{})
+		result := map[string]interface{}{}
+		switch operation {
+		case "encrypt":
+			result["ciphertext"] = "vault:v1:" + item["plaintext"].(string)
+			result["key_version"] = 1
+		case "decrypt":
+			plaintext, ok := strings.CutPrefix(item["ciphertext"].(string), "vault:v1:")
+			if ok {
+				result["plaintext"] = plaintext
+			} else {
+				result["error"] = "invalid ciphertext: no prefix"
+				failed = true
+			}
+		}
+		results = append(results, result)
+	}
+	if failed && body["partial_failure_response_code"] != json.Number("200") {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"batch_results": results}})
+}
+
+func TestEncryptSingleItem(t *testing.T) {
+	s, ft := newTransitServer(t)
+	response, err := s.Encrypt(context.Background(), &vault.EncryptRequest{
+		Key:        "app",
+		KeyVersion: 2,
+		Items:      []*vault.TransitEncryptItem{{Plaintext: []byte("hello"), Context: []byte("tenant-1")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b64 := base64.StdEncoding.EncodeToString
+	results := response.GetResults()
+	if len(results) != 1 || results[0].GetCiphertext() != "vault:v1:"+b64([]byte("hello")) || results[0].GetKeyVersion() != 1 {
+		t.Errorf("results = %v, want one ciphertext of key version 1", results)
+	}
+
+	body := ft.bodies[0]
+	wantBatch := []interface{}{map[string]interface{}{"plaintext": b64([]byte("hello")), "context": b64([]byte("tenant-1"))}}
+	if !reflect.DeepEqual(body["batch_input"], wantBatch) {
+		t.Errorf("batch_input = %v, want %v", body["batch_input"], wantBatch)
+	}
+	if body["key_version"] != json.Number("2") {
+		t.Errorf("key_version = %v, want 2", body["key_version"])
+	}
+	if body["partial_failure_response_code"] != json.Number("200") {
+		t.Errorf("partial_failure_response_code = %v, want 200", body["partial_failure_response_code"])
+	}
+}
+
+// A failed item comes back in its own result: the gateway asks Vault for 200 on partial failures,
+// so the other items of the batch are not lost.
+func TestDecryptBatchReportsItemErrors(t *testing.T) {
+	s, _ := newTransitServer(t)
+	plaintext := base64.StdEncoding.EncodeToString([]byte("hello"))
+	response, err := s.Decrypt(context.Background(), &vault.DecryptRequest{
+		Key: "app",
+		Items: []*vault.TransitDecryptItem{
+			{Ciphertext: "vault:v1:" + plaintext},
+			{Ciphertext: "garbage"},
+			{Ciphertext: "vault:v1:not base64!"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := response.GetResults()
+	if len(results) != 3 {
+		t.Fatalf("results = %v, want 3", results)
+	}
+	if string(results[0].GetPlaintext()) != "hello" || results[0].GetError() != "" {
+		t.Errorf("results[0] = %v, want plaintext hello", results[0])
+	}
+	if results[1].GetError() != "invalid ciphertext: no prefix" || results[1].GetPlaintext() != nil {
+		t.Errorf("results[1] = %v, want the Vault error", results[1])
+	}
+	if results[2].GetError() != "invalid plaintext returned by Vault" {
+		t.Errorf("results[2] = %v, want an invalid plaintext error", results[2])
+	}
+}
+
+func TestEncryptBatchKeepsOrder(t *testing.T) {
+	s, ft := newTransitServer(t)
+	response, err := s.Encrypt(context.Background(), &vault.EncryptRequest{
+		Mount: "transit",
+		Key:   "app",
+		Items: []*vault.TransitEncryptItem{{Plaintext: []byte("a")}, {Plaintext: []byte("b")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ciphertexts []string
+	for _, result := range response.GetResults() {
+		ciphertexts = append(ciphertexts, result.GetCiphertext())
+	}
+	want := []string{"vault:v1:YQ==", "vault:v1:Yg=="}
+	if !reflect.DeepEqual(ciphertexts, want) {
+		t.Errorf("ciphertexts = %v, want %v", ciphertexts, want)
+	}
+	if _, ok := ft.bodies[0]["key_version"]; ok {
+		t.Errorf("key_version sent without being requested")
+	}
+}
+
+func TestTransitErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *vault.DecryptRequest
+		wantCode codes.Code
+		wantSent bool
+	}{
+		{name: "missing key", request: &vault.DecryptRequest{Key: "other", Items: []*vault.TransitDecryptItem{{Ciphertext: "vault:v1:YQ=="}}}, wantCode: codes.InvalidArgument, wantSent: true},
+		{name: "no key name", request: &vault.DecryptRequest{Items: []*vault.TransitDecryptItem{{Ciphertext: "vault:v1:YQ=="}}}, wantCode: codes.InvalidArgument},
+		{name: "no items", request: &vault.DecryptRequest{Key: "app"}, wantCode: codes.InvalidArgument},
+		{name: "key with slash", request: &vault.DecryptRequest{Key: "app/../x", Items: []*vault.TransitDecryptItem{{Ciphertext: "vault:v1:YQ=="}}}, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ft := newTransitServer(t)
+			_, err := s.Decrypt(context.Background(), tt.request)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v (%v), want %v", code, err, tt.wantCode)
+			}
+			if sent := len(ft.bodies) > 0; sent != tt.wantSent {
+				t.Errorf("request sent to Vault = %v, want %v", sent, tt.wantSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"vault-gateway/proto/vault"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	defaultWalkConcurrency = 4
+	maxWalkConcurrency     = 16
+	defaultWalkEntries     = 10000
+	maxWalkEntries         = 100000
+)
+
+// WalkSecrets streams every secret under a folder, recursively. Folders that cannot be listed and,
+// with include_values, secrets that cannot be read are sent as entries with an error instead of
+// aborting the walk. A walk that finds more than max_entries entries fails with ResourceExhausted
+// rather than streaming an unbounded tree.
+func (s *Server) WalkSecrets(req *vault.WalkSecretsRequest, stream grpc.ServerStreamingServer[vault.WalkSecretsEntry]) error {
+	if req.GetPath() == "" {
+		return status.Error(codes.InvalidArgument, "path is required")
+	}
+	concurrency := int(req.GetConcurrency())
+	if concurrency <= 0 {
+		concurrency = defaultWalkConcurrency
+	}
+	concurrency = min(concurrency, maxWalkConcurrency)
+	maxEntries := int64(req.GetMaxEntries())
+	if maxEntries <= 0 {
+		maxEntries = defaultWalkEntries
+	}
+	maxEntries = min(maxEntries, maxWalkEntries)
+
+	ctx := stream.Context()
+	var sendMu sync.Mutex
+	var entries atomic.Int64
+	err := s.vaultClient.Walk(ctx, req.GetPath(), concurrency, func(path string, walkErr error) error {
+		if entries.Add(1) > maxEntries {
+			return status.Errorf(codes.ResourceExhausted, "more than %d entries under %s", maxEntries, req.GetPath())
+		}
+		entry := &vault.WalkSecretsEntry{Path: path}
+		if walkErr != nil {
+			entry.Error = status.Convert(vaultError(walkErr)).Message()
+		} else if req.GetIncludeValues() {
+			entry.Data, entry.Error = s.readSecretData(ctx, path)
+		}
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(entry)
+	})
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	default:
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return vaultError(err)
+	}
+}
+
+// readSecretData reads the latest version of a secret for WalkSecrets. On KV v2 only the secret
+// data is returned, without the version metadata.
+func (s *Server) readSecretData(ctx context.Context, path string) (*structpb.Struct, string) {
+	secret, err := s.vaultClient.ReadSecret(ctx, path, 0)
+	if err != nil {
+		return nil, status.Convert(vaultError(err)).Message()
+	}
+	data := secret.Data
+	if _, ok := data["metadata"].(map[string]interface{}); ok {
+		data, _ = data["data"].(map[string]interface{})
+	}
+	dataStruct, err := structpb.NewStruct(data)
+	if err != nil {
+		return nil, "failed to convert secret data"
+	}
+	return dataStruct, ""
+}
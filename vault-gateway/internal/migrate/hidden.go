@@ -0,0 +1,133 @@
+// Package migrate holds one-time data migrations run against Vault by the migrate-hidden command.
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// HiddenClient is the part of the Vault client used by HiddenSecrets.
+type HiddenClient interface {
+	List(ctx context.Context, path string) (*api.Secret, error)
+	ReadSecret(ctx context.Context, path string, version int32) (*api.Secret, error)
+	DeleteVersions(ctx context.Context, path string, versions []int32) error
+}
+
+// HiddenSecret is a secret hidden by the old soft delete of the API, which wrote "hidden": "true"
+// into its data instead of deleting it. Version is zero on KV v1.
+type HiddenSecret struct {
+	Path    string
+	Version int64
+	// Deleted reports whether HiddenSecrets soft-deleted the version.
+	Deleted bool
+}
+
+// HiddenSecrets walks the folder root and finds the secrets whose latest version was hidden by the
+// old soft delete. Unless dryRun is set, it soft-deletes that version, so it stays recoverable with
+// undelete. Only the version that was read is deleted: a version written in the meantime is kept.
+// KV v1 has no soft delete, so its hidden secrets are only reported, to be reviewed and deleted by
+// hand. Subfolders and secrets that cannot be read are logged and skipped; only a failure to list
+// root is returned.
+func HiddenSecrets(ctx context.Context, vc HiddenClient, root string, dryRun bool) ([]HiddenSecret, error) {
+	if !strings.HasSuffix(root, "/") {
+		root += "/"
+	}
+	keys, err := listKeys(ctx, vc, root)
+	if err != nil {
+		return nil, err
+	}
+	m := &hiddenMigration{vc: vc, dryRun: dryRun}
+	err = m.folder(ctx, root, keys)
+	return m.hidden, err
+}
+
+type hiddenMigration struct {
+	vc     HiddenClient
+	dryRun bool
+	hidden []HiddenSecret
+}
+
+func (m *hiddenMigration) folder(ctx context.Context, path string, keys []string) error {
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if strings.HasSuffix(key, "/") {
+			subkeys, err := listKeys(ctx, m.vc, path+key)
+			if err != nil {
+				slog.Warn("Skipping folder that could not be listed", "path", path+key, "error", err)
+				continue
+			}
+			if err := m.folder(ctx, path+key, subkeys); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := m.secret(ctx, path+key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *hiddenMigration) secret(ctx context.Context, path string) error {
+	secret, err := m.vc.ReadSecret(ctx, path, 0)
+	if err != nil {
+		slog.Warn("Skipping secret that could not be read", "path", path, "error", err)
+		return nil
+	}
+	found, ok := hiddenVersion(secret.Data)
+	if !ok {
+		return nil
+	}
+	found.Path = path
+	if found.Version > 0 && !m.dryRun {
+		if err := m.vc.DeleteVersions(ctx, path, []int32{int32(found.Version)}); err != nil {
+			return fmt.Errorf("failed to delete hidden secret %s: %w", path, err)
+		}
+		found.Deleted = true
+		slog.Info("Deleted hidden secret version", "path", path, "version", found.Version)
+	}
+	m.hidden = append(m.hidden, found)
+	return nil
+}
+
+func listKeys(ctx context.Context, vc HiddenClient, path string) ([]string, error) {
+	secret, err := vc.List(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	values, _ := secret.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		if key, ok := value.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// hiddenVersion reports whether the secret read from Vault carries the old hidden flag.
+func hiddenVersion(data map[string]interface{}) (HiddenSecret, bool) {
+	metadata, isKVv2 := data["metadata"].(map[string]interface{})
+	if isKVv2 {
+		data, _ = data["data"].(map[string]interface{})
+	}
+	if hidden, _ := data["hidden"].(string); hidden != "true" {
+		return HiddenSecret{}, false
+	}
+	if !isKVv2 {
+		return HiddenSecret{}, true
+	}
+	number, _ := metadata["version"].(json.Number)
+	version, err := number.Int64()
+	if err != nil || version <= 0 {
+		return HiddenSecret{}, false
+	}
+	return HiddenSecret{Version: version}, true
+}
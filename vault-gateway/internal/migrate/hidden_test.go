@@ -0,0 +1,124 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"vault-gateway/internal/vault_client"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// fakeHiddenClient serves fixed secrets, already in the shape Vault returns them, and lists the
+// folders that lead to them. "kv/data/forbidden/" cannot be listed.
+type fakeHiddenClient struct {
+	secrets map[string]map[string]interface{}
+	deletes []string
+}
+
+func (c *fakeHiddenClient) List(_ context.Context, path string) (*api.Secret, error) {
+	if path == "kv/data/forbidden/" {
+		return nil, errors.New("permission denied")
+	}
+	seen := make(map[string]bool)
+	for secretPath := range c.secrets {
+		rest, ok := strings.CutPrefix(secretPath, path)
+		if !ok {
+			continue
+		}
+		if folder, _, nested := strings.Cut(rest, "/"); nested {
+			rest = folder + "/"
+		}
+		seen[rest] = true
+	}
+	seen["forbidden/"] = path == "kv/data/"
+	var keys []interface{}
+	for key, ok := range seen {
+		if ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].(string) < keys[j].(string) })
+	return &api.Secret{Data: map[string]interface{}{"keys": keys}}, nil
+}
+
+func (c *fakeHiddenClient) ReadSecret(_ context.Context, path string, _ int32) (*api.Secret, error) {
+	data, ok := c.secrets[path]
+	if !ok {
+		return nil, vault_client.ErrNotFound
+	}
+	return &api.Secret{Data: data}, nil
+}
+
+func (c *fakeHiddenClient) DeleteVersions(_ context.Context, path string, versions []int32) error {
+	for _, version := range versions {
+		c.deletes = append(c.deletes, fmt.Sprintf("%s@%d", path, version))
+	}
+	return nil
+}
+
+func kvV2Secret(version string, data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"data": data, "metadata": map[string]interface{}{"version": json.Number(version)}}
+}
+
+func newFakeHiddenClient() *fakeHiddenClient {
+	return &fakeHiddenClient{secrets: map[string]map[string]interface{}{
+		"kv/data/app/hidden": kvV2Secret("3", map[string]interface{}{"password": "x", "hidden": "true"}),
+		"kv/data/visible":    kvV2Secret("1", map[string]interface{}{"password": "y"}),
+		"kv/data/false":      kvV2Secret("2", map[string]interface{}{"hidden": "false"}),
+		"kv/data/deleted":    kvV2Secret("4", nil),
+		"kv1/hidden":         {"hidden": "true"},
+	}}
+}
+
+func TestHiddenSecrets(t *testing.T) {
+	client := newFakeHiddenClient()
+	hidden, err := HiddenSecrets(context.Background(), client, "kv/data", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []HiddenSecret{{Path: "kv/data/app/hidden", Version: 3, Deleted: true}}
+	if !reflect.DeepEqual(hidden, want) {
+		t.Errorf("hidden = %+v, want %+v", hidden, want)
+	}
+	if want := []string{"kv/data/app/hidden@3"}; !reflect.DeepEqual(client.deletes, want) {
+		t.Errorf("deletes = %v, want %v", client.deletes, want)
+	}
+
+	// KV v1 secrets are only reported.
+	hidden, err = HiddenSecrets(context.Background(), client, "kv1/", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []HiddenSecret{{Path: "kv1/hidden"}}; !reflect.DeepEqual(hidden, want) {
+		t.Errorf("kv1 hidden = %+v, want %+v", hidden, want)
+	}
+	if len(client.deletes) != 1 {
+		t.Errorf("deletes = %v, want no KV v1 deletes", client.deletes)
+	}
+}
+
+func TestHiddenSecretsDryRun(t *testing.T) {
+	client := newFakeHiddenClient()
+	hidden, err := HiddenSecrets(context.Background(), client, "kv/data/", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hidden) != 1 || hidden[0].Deleted || hidden[0].Version != 3 {
+		t.Errorf("hidden = %+v, want the secret reported without deletes", hidden)
+	}
+	if len(client.deletes) != 0 {
+		t.Errorf("dry run deleted %v", client.deletes)
+	}
+}
+
+func TestHiddenSecretsRootNotListable(t *testing.T) {
+	if _, err := HiddenSecrets(context.Background(), newFakeHiddenClient(), "kv/data/forbidden", false); err == nil {
+		t.Fatal("HiddenSecrets succeeded on a folder that cannot be listed")
+	}
+}
@@ -2,16 +2,38 @@ package vault_client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"vault-gateway/internal/config"
+	"vault-gateway/internal/vaultauth"
 
 	"github.com/hashicorp/vault/api"
-	"github.com/hashicorp/vault/api/auth/approle"
+)
+
+var (
+	// ErrInvalidPath is returned when a path does not have the shape an operation requires.
+	ErrInvalidPath = errors.New("invalid secret path")
+	// ErrNotFound is returned when Vault has nothing at the requested path.
+	ErrNotFound = errors.New("secret not found")
 )
 
 type VaultClient struct {
 	vaultClient *api.Client
+	authMethod  api.AuthMethod
+	clock       clock
+
+	mu            sync.Mutex
+	healthy       bool
+	healthHandler func(healthy bool)
+
+	mountsMu sync.Mutex
+	mounts   map[string]*mountTable
 }
 
 func NewVaultClient(ctx context.Context, cfg *config.Config) (*VaultClient, error) {
@@ -22,99 +44,256 @@ func NewVaultClient(ctx context.Context, cfg *config.Config) (*VaultClient, erro
 		return nil, fmt.Errorf("failed to create Vault client: %w", err)
 	}
 
-	appRoleAuth, err := approle.NewAppRoleAuth(cfg.VaultSrvRoleID, &approle.SecretID{FromString: cfg.VaultSrvSecretID})
+	authMethod, err := vaultauth.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	vc, err := newVaultClient(ctx, client, authMethod, realClock{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create AppRole auth: %w", err)
+		return nil, err
 	}
+	slog.Info("Vault login successful", "auth_method", cfg.VaultAuthMethod)
+	return vc, nil
+}
 
-	authInfo, err := client.Auth().Login(ctx, appRoleAuth)
+// newVaultClient logs in with authMethod and keeps the token alive until ctx is done, re-logging in
+// with backoff measured by clock.
+func newVaultClient(ctx context.Context, client *api.Client, authMethod api.AuthMethod, clock clock) (*VaultClient, error) {
+	authInfo, err := client.Auth().Login(ctx, authMethod)
 	if err != nil {
 		return nil, fmt.Errorf("failed to login to Vault: %w", err)
 	}
-	slog.Info("Vault login successful")
-
-	go setupTokenRenewal(ctx, client, authInfo)
-	return &VaultClient{vaultClient: client}, nil
-}
-
-func setupTokenRenewal(ctx context.Context, client *api.Client, token *api.Secret) {
-	watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{
-		Secret:    token,
-		Increment: 3600,
-	})
-	if err != nil {
-		slog.Error("Failed to create lifetime watcher", "error", err)
-		return
-	}
-
-	slog.Info("Starting Vault token renewal watcher")
-	go watcher.Start()
-	slog.Info("Vault token renewal watcher started")
-	go func() {
-		defer watcher.Stop()
-		for {
-			select {
-			case err := <-watcher.DoneCh():
-				if err != nil {
-					slog.Error("Vault token renewal watcher stopped with error", "error", err)
-				} else {
-					slog.Info("Vault token renewal watcher stopped successfully")
-				}
-				return
-			case renewal := <-watcher.RenewCh():
-				if renewal != nil {
-					slog.Info("Vault token renewed", "lease_id", renewal.Secret.LeaseID, "renewable", renewal.Secret.Renewable, "lease_duration", renewal.Secret.LeaseDuration)
-				} else {
-					slog.Info("Vault token renewed with no additional information")
-				}
-			case <-ctx.Done():
-				slog.Info("Context done, stopping Vault token renewal watcher")
-				return
-			}
-		}
-	}()
+	vc := &VaultClient{vaultClient: client, authMethod: authMethod, clock: clock, healthy: true}
+	go vc.manageTokenLifecycle(ctx, authInfo)
+	return vc, nil
 }
 
-func (vc *VaultClient) ReadSecret(ctx context.Context, path string) (*api.Secret, error) {
-	secret, err := vc.vaultClient.Logical().Read(path)
+// ReadSecret reads the secret at path. For KV v2 data paths, a version greater than zero reads
+// that specific version instead of the latest one.
+func (vc *VaultClient) ReadSecret(ctx context.Context, path string, version int32) (*api.Secret, error) {
+	var secret *api.Secret
+	var err error
+	if version > 0 {
+		secret, err = vc.client(ctx).Logical().ReadWithDataWithContext(ctx, path, map[string][]string{"version": {strconv.Itoa(int(version))}})
+	} else {
+		secret, err = vc.client(ctx).Logical().ReadWithContext(ctx, path)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read from Vault at path %s: %w", path, err)
 	}
 	if secret == nil {
-		return nil, fmt.Errorf("no data found at path %s", path)
+		return nil, fmt.Errorf("%w: no data found at path %s", ErrNotFound, path)
 	}
 	return secret, nil
 }
 
+// WriteSecret writes data at path. Data is the body of the Vault request, which for KV v2 wraps the
+// secret in "data". On a KV v1 mount that wrapper is removed, so callers can write to both versions
+// the same way; check-and-set options are rejected there since KV v1 does not support them. If the
+// mount cannot be resolved the write fails, since on KV v1 the wrapper would be stored as data.
 func (vc *VaultClient) WriteSecret(ctx context.Context, path string, data map[string]interface{}) (*api.Secret, error) {
-	secret, err := vc.vaultClient.Logical().Write(path, data)
+	mount, err := vc.kvMount(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write to Vault at path '%s': cannot tell its KV version: %w", path, err)
+	}
+	if mount != nil && mount.version == 1 {
+		if _, ok := data["options"]; ok {
+			return nil, fmt.Errorf("%w: check-and-set is not supported on %s", ErrNotKVv2, mount.path)
+		}
+		if inner, ok := data["data"].(map[string]interface{}); ok && len(data) == 1 {
+			data = inner
+		}
+	}
+	secret, err := vc.client(ctx).Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to write to Vault at path '%s': %w", path, err)
 	}
 	if secret == nil {
-		return nil, fmt.Errorf("no response from Vault after writing to path %s", path)
+		// KV v1 answers writes with 204 No Content.
+		return &api.Secret{}, nil
 	}
 	return secret, nil
 }
 
+// IsCASMismatch reports whether Vault rejected a KV v2 write because the cas version did not match.
+func IsCASMismatch(err error) bool {
+	var respErr *api.ResponseError
+	if !errors.As(err, &respErr) || respErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, message := range respErr.Errors {
+		if strings.Contains(message, "check-and-set") {
+			return true
+		}
+	}
+	return false
+}
+
+// PatchSecret applies a JSON merge patch (RFC 7396) to the latest version of a KV v2 secret:
+// keys set to null are removed and the others are added or replaced. When cas is not nil the patch
+// only applies if the current version matches it. Path must be the data path of the secret.
+func (vc *VaultClient) PatchSecret(ctx context.Context, path string, data map[string]interface{}, cas *int32) (*api.Secret, error) {
+	dataPath, err := vc.kvPath(ctx, path, "data", false)
+	if err != nil {
+		return nil, err
+	}
+	if dataPath != strings.TrimPrefix(path, "/") {
+		return nil, fmt.Errorf("%w: %q is not a KV v2 data path (<mount>/data/<path>)", ErrInvalidPath, path)
+	}
+	body := map[string]interface{}{"data": data}
+	if cas != nil {
+		body["options"] = map[string]interface{}{"cas": *cas}
+	}
+	secret, err := vc.client(ctx).Logical().JSONMergePatch(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch Vault secret at path %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: no secret to patch at path %s", ErrNotFound, path)
+	}
+	return secret, nil
+}
+
+// List lists the keys under path. On KV v2 mounts the listing is done on the metadata endpoint, so
+// either the data or the metadata path of a folder can be given.
 func (vc *VaultClient) List(ctx context.Context, path string) (*api.Secret, error) {
-	secret, err := vc.vaultClient.Logical().List(path)
+	if mount, err := vc.kvMount(ctx, path); err == nil && mount != nil && mount.version == 2 {
+		metadataPath, err := vc.kvPath(ctx, path, "metadata", true)
+		if err != nil {
+			return nil, err
+		}
+		path = metadataPath
+	}
+	secret, err := vc.client(ctx).Logical().ListWithContext(ctx, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list at Vault path %s: %w", path, err)
 	}
 	if secret == nil {
-		return nil, fmt.Errorf("no data found at Vault path %s", path)
+		return nil, fmt.Errorf("%w: nothing to list at Vault path %s", ErrNotFound, path)
 	}
 	return secret, nil
 }
 
+// Delete removes the secret at path. On a KV v2 data path this soft-deletes the latest version.
+// Other KV v2 paths are rejected: a delete on "<mount>/metadata/<path>" removes every version for
+// good, which callers must do through Destroy. Vault answers deletes with 204 No Content, so a nil
+// secret is not an error.
 func (vc *VaultClient) Delete(ctx context.Context, path string) error {
-	secret, err := vc.vaultClient.Logical().Delete(path)
+	if err := vc.checkDeletePath(ctx, path); err != nil {
+		return err
+	}
+	_, err := vc.client(ctx).Logical().DeleteWithContext(ctx, path)
 	if err != nil {
 		return fmt.Errorf("failed to delete at Vault path %s: %w", path, err)
 	}
+	return nil
+}
+
+// checkDeletePath only lets data paths through on KV v2 mounts. If the mounts cannot be read, any
+// path with a "metadata" segment is rejected, like kvPath falls back to splitting on "/data/".
+func (vc *VaultClient) checkDeletePath(ctx context.Context, path string) error {
+	mount, err := vc.kvMount(ctx, path)
+	if err != nil {
+		if slices.Contains(strings.Split(path, "/"), "metadata") {
+			return fmt.Errorf("%w: %q looks like a KV v2 metadata path; destroy its versions instead", ErrInvalidPath, path)
+		}
+		return nil
+	}
+	if mount == nil || mount.version != 2 {
+		return nil
+	}
+	relative := strings.TrimPrefix(strings.TrimPrefix(path, "/"), mount.path)
+	if section, secretPath, _ := strings.Cut(relative, "/"); section != "data" || secretPath == "" {
+		return fmt.Errorf("%w: %q is not a KV v2 data path (<mount>/data/<path>); destroy its versions instead", ErrInvalidPath, path)
+	}
+	return nil
+}
+
+// DeleteVersions soft-deletes the given versions of a KV v2 secret.
+func (vc *VaultClient) DeleteVersions(ctx context.Context, path string, versions []int32) error {
+	return vc.versionsOperation(ctx, path, "delete", versions)
+}
+
+// Undelete restores soft-deleted versions of a KV v2 secret.
+func (vc *VaultClient) Undelete(ctx context.Context, path string, versions []int32) error {
+	return vc.versionsOperation(ctx, path, "undelete", versions)
+}
+
+// Destroy permanently removes the data of the given versions of a KV v2 secret.
+func (vc *VaultClient) Destroy(ctx context.Context, path string, versions []int32) error {
+	return vc.versionsOperation(ctx, path, "destroy", versions)
+}
+
+// ReadMetadata reads the KV v2 metadata (versions, custom metadata and settings) of the secret
+// whose data path is path.
+func (vc *VaultClient) ReadMetadata(ctx context.Context, path string) (*api.Secret, error) {
+	metadataPath, err := vc.kvPath(ctx, path, "metadata", false)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := vc.client(ctx).Logical().ReadWithContext(ctx, metadataPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata from Vault at path %s: %w", metadataPath, err)
+	}
 	if secret == nil {
-		return fmt.Errorf("no response from Vault after deleting at path %s", path)
+		return nil, fmt.Errorf("%w: no metadata found at path %s", ErrNotFound, metadataPath)
+	}
+	return secret, nil
+}
+
+// WriteMetadata updates the KV v2 metadata settings (custom_metadata, max_versions, cas_required,
+// delete_version_after) of the secret whose data path is path.
+func (vc *VaultClient) WriteMetadata(ctx context.Context, path string, data map[string]interface{}) error {
+	metadataPath, err := vc.kvPath(ctx, path, "metadata", false)
+	if err != nil {
+		return err
+	}
+	if _, err := vc.client(ctx).Logical().WriteWithContext(ctx, metadataPath, data); err != nil {
+		return fmt.Errorf("failed to write metadata to Vault at path %s: %w", metadataPath, err)
+	}
+	return nil
+}
+
+func (vc *VaultClient) versionsOperation(ctx context.Context, path, endpoint string, versions []int32) error {
+	endpointPath, err := vc.kvPath(ctx, path, endpoint, false)
+	if err != nil {
+		return err
+	}
+	_, err = vc.client(ctx).Logical().WriteWithContext(ctx, endpointPath, map[string]interface{}{"versions": versions})
+	if err != nil {
+		return fmt.Errorf("failed to %s versions at Vault path %s: %w", endpoint, path, err)
 	}
 	return nil
 }
+
+// KVv2Path converts a KV v2 data path ("<mount>/data/<secret>") into the path of another endpoint
+// of the same engine, e.g. "<mount>/destroy/<secret>", assuming the mount is everything before the
+// first "/data/".
+func KVv2Path(path, endpoint string) (string, error) {
+	mount, secretPath, found := strings.Cut(path, "/data/")
+	if !found || mount == "" || secretPath == "" {
+		return "", fmt.Errorf("%w: %q is not a KV v2 data path (<mount>/data/<path>)", ErrInvalidPath, path)
+	}
+	return mount + "/" + endpoint + "/" + secretPath, nil
+}
+
+// enginePath builds "<mount>/<endpoint>/<name>" for a secrets engine endpoint addressed by name
+// (a role or key), or "<mount>/<endpoint>" when name is empty. The mount may be nested, but neither
+// part may contain empty, "." or ".." segments, and name must be a single segment.
+func enginePath(mount, endpoint, name string) (string, error) {
+	mount = strings.Trim(mount, "/")
+	invalid := mount == "" || strings.Contains(name, "/") || name == "." || name == ".."
+	for _, segment := range strings.Split(mount, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			invalid = true
+		}
+	}
+	if invalid {
+		return "", fmt.Errorf("%w: invalid mount %q or name %q", ErrInvalidPath, mount, name)
+	}
+	if name == "" {
+		return mount + "/" + endpoint, nil
+	}
+	return mount + "/" + endpoint + "/" + name, nil
+}
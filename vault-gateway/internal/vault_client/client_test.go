@@ -0,0 +1,316 @@
+package vault_client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// fakeVault is a Vault with a KV v2 mount at "secret/", a KV v1 mount at "kv/", a KV v2 mount at
+// "team/data/" (a mount whose name contains "data") and a transit mount. sys/mounts and
+// sys/internal/ui/mounts can be denied, like for tokens without access to them. Every request is
+// recorded as "METHOD /v1/path", followed by " ns=<namespace>" when it carries a namespace.
+type fakeVault struct {
+	mountsDenied   bool
+	uiMountsDenied bool
+
+	mu       sync.Mutex
+	requests []string
+	deleted  []string
+	patches  []fakePatch
+	writes   []fakeWrite
+}
+
+type fakePatch struct {
+	path        string
+	contentType string
+	body        map[string]interface{}
+}
+
+type fakeWrite struct {
+	path string
+	body map[string]interface{}
+}
+
+var fakeMounts = map[string]map[string]interface{}{
+	"secret/":    {"type": "kv", "options": map[string]string{"version": "2"}},
+	"kv/":        {"type": "kv", "options": map[string]string{"version": "1"}},
+	"team/data/": {"type": "kv", "options": map[string]string{"version": "2"}},
+	"transit/":   {"type": "transit"},
+}
+
+// newTestClient returns a client for fake. Patches answer with version 2, except on
+// "secret/data/missing", which does not exist.
+func newTestClient(t *testing.T, fake *fakeVault) *VaultClient {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(&api.Config{Address: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("s.test")
+	return &VaultClient{vaultClient: client, healthy: true}
+}
+
+func (fake *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	method := r.Method
+	if r.URL.Query().Get("list") == "true" {
+		method = "LIST"
+	}
+	request := method + " " + r.URL.Path
+	if namespace := r.Header.Get("X-Vault-Namespace"); namespace != "" {
+		request += " ns=" + namespace
+	}
+	fake.requests = append(fake.requests, request)
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		decoder.Decode(&body)
+	}
+	switch {
+	case r.URL.Path == "/v1/sys/mounts":
+		if fake.mountsDenied {
+			writeJSON(w, http.StatusForbidden, map[string][]string{"errors": {"permission denied"}})
+			return
+		}
+		data := map[string]interface{}{}
+		for path, mount := range fakeMounts {
+			data[path] = mount
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+	case strings.HasPrefix(r.URL.Path, "/v1/sys/internal/ui/mounts/"):
+		if fake.uiMountsDenied {
+			writeJSON(w, http.StatusForbidden, map[string][]string{"errors": {"permission denied"}})
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v1/sys/internal/ui/mounts/")
+		found := ""
+		for mount := range fakeMounts {
+			if strings.HasPrefix(path+"/", mount) && len(mount) > len(found) {
+				found = mount
+			}
+		}
+		if found == "" {
+			writeJSON(w, http.StatusBadRequest, map[string][]string{"errors": {"no mount found"}})
+			return
+		}
+		data := map[string]interface{}{"path": found}
+		for key, value := range fakeMounts[found] {
+			data[key] = value
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": data})
+	case method == "LIST":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"keys": []string{"app", "team/"}}})
+	case method == http.MethodDelete:
+		fake.deleted = append(fake.deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	case method == http.MethodPut || method == http.MethodPost:
+		fake.writes = append(fake.writes, fakeWrite{path: r.URL.Path, body: body})
+		if strings.HasPrefix(r.URL.Path, "/v1/kv/") {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": 1}})
+	case method == http.MethodPatch:
+		fake.patches = append(fake.patches, fakePatch{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), body: body})
+		if r.URL.Path == "/v1/secret/data/missing" {
+			writeJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": 2}})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string][]string{"errors": {}})
+	}
+}
+
+// requestsTo returns the recorded requests that start with prefix.
+func (fake *fakeVault) requestsTo(prefix string) []string {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	var matching []string
+	for _, request := range fake.requests {
+		if strings.HasPrefix(request, prefix) {
+			matching = append(matching, request)
+		}
+	}
+	return matching
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func TestDeleteRejectsKVv2MetadataPaths(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		mountsReadable bool
+		wantErr        error
+	}{
+		{name: "KV v2 data path", path: "secret/data/app", mountsReadable: true},
+		{name: "KV v2 metadata path", path: "secret/metadata/app", mountsReadable: true, wantErr: ErrInvalidPath},
+		{name: "KV v2 metadata root", path: "secret/metadata/", mountsReadable: true, wantErr: ErrInvalidPath},
+		{name: "KV v2 mount root", path: "secret/data", mountsReadable: true, wantErr: ErrInvalidPath},
+		{name: "KV v1 secret named metadata", path: "kv/metadata/app", mountsReadable: true},
+		{name: "mounts resolved per path, data path", path: "secret/data/app"},
+		{name: "mounts resolved per path, metadata path", path: "secret/metadata/app", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeVault{mountsDenied: !tt.mountsReadable}
+			vc := newTestClient(t, fake)
+			err := vc.Delete(context.Background(), tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			var want []string
+			if tt.wantErr == nil {
+				want = []string{"/v1/" + tt.path}
+			}
+			if !reflect.DeepEqual(fake.deleted, want) {
+				t.Errorf("deleted = %v, want %v", fake.deleted, want)
+			}
+		})
+	}
+}
+
+func TestPatchSecret(t *testing.T) {
+	cas := int32(1)
+	tests := []struct {
+		name     string
+		path     string
+		cas      *int32
+		wantErr  error
+		wantSent bool
+		wantBody map[string]interface{}
+	}{
+		{name: "merge patch", path: "secret/data/app", wantSent: true, wantBody: map[string]interface{}{"data": map[string]interface{}{"user": "app", "old": nil}}},
+		{name: "with cas", path: "secret/data/app", cas: &cas, wantSent: true, wantBody: map[string]interface{}{
+			"data":    map[string]interface{}{"user": "app", "old": nil},
+			"options": map[string]interface{}{"cas": json.Number("1")},
+		}},
+		{name: "KV v1 mount", path: "kv/app", wantErr: ErrNotKVv2},
+		{name: "metadata path", path: "secret/metadata/app", wantErr: ErrInvalidPath},
+		{name: "not under a mount", path: "other/data/app", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeVault{}
+			vc := newTestClient(t, fake)
+			secret, err := vc.PatchSecret(context.Background(), tt.path, map[string]interface{}{"user": "app", "old": nil}, tt.cas)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if sent := len(fake.patches) > 0; sent != tt.wantSent {
+				t.Fatalf("patch sent = %v, want %v", sent, tt.wantSent)
+			}
+			if !tt.wantSent {
+				return
+			}
+			patch := fake.patches[0]
+			if patch.path != "/v1/"+tt.path || patch.contentType != "application/merge-patch+json" {
+				t.Errorf("patch = %s (%s), want /v1/%s as application/merge-patch+json", patch.path, patch.contentType, tt.path)
+			}
+			if !reflect.DeepEqual(patch.body, tt.wantBody) {
+				t.Errorf("body = %v, want %v", patch.body, tt.wantBody)
+			}
+			if secret.Data["version"] != json.Number("2") {
+				t.Errorf("version = %v, want 2", secret.Data["version"])
+			}
+		})
+	}
+}
+
+func TestPatchSecretMissing(t *testing.T) {
+	vc := newTestClient(t, &fakeVault{})
+	_, err := vc.PatchSecret(context.Background(), "secret/data/missing", map[string]interface{}{"user": "app"}, nil)
+	var respErr *api.ResponseError
+	if !errors.As(err, &respErr) || respErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want the Vault 404", err)
+	}
+}
+
+func TestWriteSecretKVVersion(t *testing.T) {
+	secret := map[string]interface{}{"data": map[string]interface{}{"password": "x"}}
+	tests := []struct {
+		name     string
+		fake     *fakeVault
+		path     string
+		data     map[string]interface{}
+		wantErr  bool
+		wantBody map[string]interface{}
+	}{
+		{name: "KV v2 keeps the wrapper", fake: &fakeVault{}, path: "secret/data/app", data: secret, wantBody: secret},
+		{name: "KV v1 unwraps", fake: &fakeVault{}, path: "kv/app", data: secret, wantBody: map[string]interface{}{"password": "x"}},
+		{name: "KV v1 rejects cas", fake: &fakeVault{}, path: "kv/app", data: map[string]interface{}{"data": map[string]interface{}{"password": "x"}, "options": map[string]interface{}{"cas": 1}}, wantErr: true},
+		{name: "KV v1 resolved per path", fake: &fakeVault{mountsDenied: true}, path: "kv/app", data: secret, wantBody: map[string]interface{}{"password": "x"}},
+		{name: "KV v2 resolved per path", fake: &fakeVault{mountsDenied: true}, path: "secret/data/app", data: secret, wantBody: secret},
+		{name: "unknown mount fails closed", fake: &fakeVault{mountsDenied: true, uiMountsDenied: true}, path: "kv/app", data: secret, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := newTestClient(t, tt.fake)
+			_, err := vc.WriteSecret(context.Background(), tt.path, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(tt.fake.writes) != 0 {
+					t.Errorf("writes = %v, want none", tt.fake.writes)
+				}
+				return
+			}
+			if len(tt.fake.writes) != 1 || tt.fake.writes[0].path != "/v1/"+tt.path {
+				t.Fatalf("writes = %v, want one to %s", tt.fake.writes, tt.path)
+			}
+			if !reflect.DeepEqual(tt.fake.writes[0].body, tt.wantBody) {
+				t.Errorf("body = %v, want %v", tt.fake.writes[0].body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestListKVVersions(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "secret/data/", want: "LIST /v1/secret/metadata"},
+		{path: "secret/data/team", want: "LIST /v1/secret/metadata/team"},
+		{path: "secret/metadata/team/", want: "LIST /v1/secret/metadata/team"},
+		{path: "team/data/data/app", want: "LIST /v1/team/data/metadata/app"},
+		{path: "kv/team", want: "LIST /v1/kv/team"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			fake := &fakeVault{}
+			vc := newTestClient(t, fake)
+			secret, err := vc.List(context.Background(), tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fake.requestsTo("LIST"); !reflect.DeepEqual(got, []string{tt.want}) {
+				t.Errorf("requests = %v, want %v", got, tt.want)
+			}
+			if keys, _ := secret.Data["keys"].([]interface{}); len(keys) != 2 {
+				t.Errorf("keys = %v, want 2", secret.Data["keys"])
+			}
+		})
+	}
+}
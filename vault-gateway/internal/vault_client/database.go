@@ -0,0 +1,47 @@
+package vault_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// GenerateDatabaseCredentials asks the database secrets engine mounted at mount for a new set of
+// credentials for role. The returned secret carries the lease that controls their lifetime.
+func (vc *VaultClient) GenerateDatabaseCredentials(ctx context.Context, mount, role string) (*api.Secret, error) {
+	path, err := enginePath(mount, "creds", role)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := vc.client(ctx).Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate database credentials at path %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: no credentials returned at path %s", ErrNotFound, path)
+	}
+	return secret, nil
+}
+
+// RenewLease extends the lease identified by leaseID through sys/leases/renew. The increment, in
+// seconds, is only a request: Vault may grant less, up to the max TTL of the lease.
+func (vc *VaultClient) RenewLease(ctx context.Context, leaseID string, increment int) (*api.Secret, error) {
+	secret, err := vc.client(ctx).Sys().RenewWithContext(ctx, leaseID, increment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew lease %s: %w", leaseID, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: no response renewing lease %s", ErrNotFound, leaseID)
+	}
+	return secret, nil
+}
+
+// RevokeLease revokes the lease identified by leaseID through sys/leases/revoke, invalidating the
+// credentials it was issued with.
+func (vc *VaultClient) RevokeLease(ctx context.Context, leaseID string) error {
+	if err := vc.client(ctx).Sys().RevokeWithContext(ctx, leaseID); err != nil {
+		return fmt.Errorf("failed to revoke lease %s: %w", leaseID, err)
+	}
+	return nil
+}
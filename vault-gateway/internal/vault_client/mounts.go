@@ -0,0 +1,158 @@
+package vault_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrNotKVv2 is returned for operations that only exist on KV v2 mounts.
+var ErrNotKVv2 = errors.New("not a KV v2 mount")
+
+const (
+	mountCacheTTL      = 5 * time.Minute
+	mountMinRefreshGap = 10 * time.Second
+)
+
+type kvMount struct {
+	path    string // with trailing "/", as returned by sys/mounts
+	version int
+}
+
+type mountTable struct {
+	mounts    []kvMount
+	fetchedAt time.Time
+	err       error
+}
+
+// kvMount returns the KV mount that contains path, or nil when path is not under a KV mount. Mounts
+// are read from sys/mounts and cached per namespace; an unknown path forces a refresh so that new
+// mounts are picked up without waiting for the cache to expire. Tokens that cannot read sys/mounts
+// resolve the mount of each path through sys/internal/ui/mounts instead, which only needs access to
+// the path itself; if that fails too, the error is returned.
+func (vc *VaultClient) kvMount(ctx context.Context, path string) (*kvMount, error) {
+	namespace := namespaceFromContext(ctx)
+	table := vc.cachedMounts(namespace)
+	if table == nil || time.Since(table.fetchedAt) > mountCacheTTL {
+		table = vc.refreshMounts(ctx, namespace)
+	}
+	mount := table.lookup(path)
+	if mount == nil && table.err == nil && time.Since(table.fetchedAt) > mountMinRefreshGap {
+		table = vc.refreshMounts(ctx, namespace)
+		mount = table.lookup(path)
+	}
+	if mount != nil || table.err == nil {
+		return mount, nil
+	}
+	return vc.resolveMount(ctx, namespace, table, path)
+}
+
+// resolveMount asks sys/internal/ui/mounts for the mount of path and remembers it in table, so
+// later requests under the same mount do not ask again until the table expires.
+func (vc *VaultClient) resolveMount(ctx context.Context, namespace string, table *mountTable, path string) (*kvMount, error) {
+	secret, err := vc.client(ctx).Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the mount of %s (%v): %w", path, table.err, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: no mount found for %s (%v)", ErrNotFound, path, table.err)
+	}
+	mountPath, _ := secret.Data["path"].(string)
+	mountType, _ := secret.Data["type"].(string)
+	if mountPath == "" || (mountType != "kv" && mountType != "generic") {
+		return nil, nil
+	}
+	mount := kvMount{path: strings.TrimPrefix(mountPath, "/"), version: 1}
+	if options, _ := secret.Data["options"].(map[string]interface{}); options["version"] == "2" {
+		mount.version = 2
+	}
+	if !strings.HasSuffix(mount.path, "/") {
+		mount.path += "/"
+	}
+
+	// Tables are never modified once published, so lookups need no lock.
+	learned := &mountTable{mounts: append(slices.Clone(table.mounts), mount), fetchedAt: table.fetchedAt, err: table.err}
+	vc.mountsMu.Lock()
+	if vc.mounts[namespace] == table {
+		vc.mounts[namespace] = learned
+	}
+	vc.mountsMu.Unlock()
+	return &mount, nil
+}
+
+func (vc *VaultClient) cachedMounts(namespace string) *mountTable {
+	vc.mountsMu.Lock()
+	defer vc.mountsMu.Unlock()
+	return vc.mounts[namespace]
+}
+
+func (vc *VaultClient) refreshMounts(ctx context.Context, namespace string) *mountTable {
+	table := &mountTable{fetchedAt: time.Now()}
+	mounts, err := vc.client(ctx).Sys().ListMountsWithContext(ctx)
+	if err != nil {
+		slog.Warn("Failed to read Vault mounts, resolving them per path", "namespace", namespace, "error", err)
+		table.err = fmt.Errorf("failed to list Vault mounts: %w", err)
+	}
+	for path, mount := range mounts {
+		if mount.Type != "kv" && mount.Type != "generic" {
+			continue
+		}
+		version := 1
+		if mount.Options["version"] == "2" {
+			version = 2
+		}
+		table.mounts = append(table.mounts, kvMount{path: path, version: version})
+	}
+
+	vc.mountsMu.Lock()
+	defer vc.mountsMu.Unlock()
+	if vc.mounts == nil {
+		vc.mounts = make(map[string]*mountTable)
+	}
+	vc.mounts[namespace] = table
+	return table
+}
+
+// lookup returns the longest mount that is a prefix of path.
+func (t *mountTable) lookup(path string) *kvMount {
+	path = strings.TrimPrefix(path, "/")
+	var found *kvMount
+	for i, mount := range t.mounts {
+		if strings.HasPrefix(path+"/", mount.path) && (found == nil || len(mount.path) > len(found.path)) {
+			found = &t.mounts[i]
+		}
+	}
+	return found
+}
+
+// kvPath converts a KV v2 data or metadata path into the path of another endpoint of the same
+// engine, e.g. "<mount>/data/<secret>" into "<mount>/destroy/<secret>". The mount is resolved as in
+// kvMount, so mounts and secrets containing "data" are handled; if it cannot be resolved at all, the
+// path is split on its first "/data/" as before. allowRoot accepts the root of the mount,
+// which is only meaningful for listings.
+func (vc *VaultClient) kvPath(ctx context.Context, path, endpoint string, allowRoot bool) (string, error) {
+	mount, err := vc.kvMount(ctx, path)
+	if err != nil {
+		return KVv2Path(path, endpoint)
+	}
+	if mount == nil {
+		return "", fmt.Errorf("%w: %q is not under a KV mount", ErrInvalidPath, path)
+	}
+	if mount.version != 2 {
+		return "", fmt.Errorf("%w: %s is a KV v%d mount", ErrNotKVv2, mount.path, mount.version)
+	}
+
+	relative := strings.TrimPrefix(strings.TrimPrefix(path, "/"), mount.path)
+	section, secretPath, _ := strings.Cut(relative, "/")
+	if section != "data" && section != "metadata" {
+		return "", fmt.Errorf("%w: %q is not a KV v2 data path (<mount>/data/<path>)", ErrInvalidPath, path)
+	}
+	if secretPath == "" && !allowRoot {
+		return "", fmt.Errorf("%w: %q does not name a secret", ErrInvalidPath, path)
+	}
+	return mount.path + endpoint + "/" + secretPath, nil
+}
@@ -0,0 +1,155 @@
+package vault_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMountTableLookup(t *testing.T) {
+	table := &mountTable{mounts: []kvMount{
+		{path: "secret/", version: 2},
+		{path: "kv/", version: 1},
+		{path: "team/", version: 1},
+		{path: "team/data/", version: 2},
+	}}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "secret/data/app", want: "secret/"},
+		{path: "/secret/data/app", want: "secret/"},
+		{path: "secret", want: "secret/"},
+		{path: "kv/app", want: "kv/"},
+		{path: "team/data/app", want: "team/data/"},
+		{path: "team/app", want: "team/"},
+		{path: "secretary/app"},
+		{path: "other/app"},
+		{path: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			mount := table.lookup(tt.path)
+			got := ""
+			if mount != nil {
+				got = mount.path
+			}
+			if got != tt.want {
+				t.Errorf("lookup(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKVMount(t *testing.T) {
+	fake := &fakeVault{}
+	vc := newTestClient(t, fake)
+	ctx := context.Background()
+
+	mount, err := vc.kvMount(ctx, "secret/data/app")
+	if err != nil || mount == nil || mount.path != "secret/" || mount.version != 2 {
+		t.Fatalf("kvMount = %+v, %v, want secret/ (v2)", mount, err)
+	}
+	if mount, err := vc.kvMount(ctx, "kv/app"); err != nil || mount == nil || mount.version != 1 {
+		t.Errorf("kvMount(kv/app) = %+v, %v, want kv/ (v1)", mount, err)
+	}
+	if mount, err := vc.kvMount(ctx, "transit/encrypt/app"); err != nil || mount != nil {
+		t.Errorf("kvMount(transit/...) = %+v, %v, want no KV mount", mount, err)
+	}
+	if got := fake.requestsTo("GET /v1/sys/mounts"); len(got) != 1 {
+		t.Errorf("sys/mounts read %d times, want once while the cache is fresh", len(got))
+	}
+
+	// An unknown path refreshes the table, but not more often than mountMinRefreshGap.
+	vc.mounts[""].fetchedAt = time.Now().Add(-2 * mountMinRefreshGap)
+	if mount, err := vc.kvMount(ctx, "new/app"); err != nil || mount != nil {
+		t.Errorf("kvMount(new/app) = %+v, %v, want no KV mount", mount, err)
+	}
+	if _, err := vc.kvMount(ctx, "new/app"); err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.requestsTo("GET /v1/sys/mounts"); len(got) != 2 {
+		t.Errorf("sys/mounts read %d times, want one refresh for the unknown path", len(got))
+	}
+}
+
+// Tokens that cannot read sys/mounts resolve the mount of each path through
+// sys/internal/ui/mounts, once per mount.
+func TestKVMountResolvedPerPath(t *testing.T) {
+	fake := &fakeVault{mountsDenied: true}
+	vc := newTestClient(t, fake)
+	ctx := context.Background()
+
+	for _, path := range []string{"kv/app", "kv/other"} {
+		mount, err := vc.kvMount(ctx, path)
+		if err != nil || mount == nil || mount.path != "kv/" || mount.version != 1 {
+			t.Fatalf("kvMount(%s) = %+v, %v, want kv/ (v1)", path, mount, err)
+		}
+	}
+	if mount, err := vc.kvMount(ctx, "team/data/data/app"); err != nil || mount == nil || mount.path != "team/data/" || mount.version != 2 {
+		t.Errorf("kvMount(team/data/...) = %+v, %v, want team/data/ (v2)", mount, err)
+	}
+	if mount, err := vc.kvMount(ctx, "transit/encrypt/app"); err != nil || mount != nil {
+		t.Errorf("kvMount(transit/...) = %+v, %v, want no KV mount", mount, err)
+	}
+	want := []string{
+		"GET /v1/sys/internal/ui/mounts/kv/app",
+		"GET /v1/sys/internal/ui/mounts/team/data/data/app",
+		"GET /v1/sys/internal/ui/mounts/transit/encrypt/app",
+	}
+	if got := fake.requestsTo("GET /v1/sys/internal"); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("ui mount requests = %v, want %v", got, want)
+	}
+	if got := fake.requestsTo("GET /v1/sys/mounts"); len(got) != 1 {
+		t.Errorf("sys/mounts read %d times, want once until the cache expires", len(got))
+	}
+
+	denied := newTestClient(t, &fakeVault{mountsDenied: true, uiMountsDenied: true})
+	if _, err := denied.kvMount(ctx, "kv/app"); err == nil {
+		t.Error("kvMount succeeded with no way to resolve the mount")
+	}
+}
+
+func TestKVPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		fake      *fakeVault
+		path      string
+		endpoint  string
+		allowRoot bool
+		want      string
+		wantErr   error
+	}{
+		{name: "data to destroy", path: "secret/data/app", endpoint: "destroy", want: "secret/destroy/app"},
+		{name: "metadata to data", path: "secret/metadata/team/app", endpoint: "data", want: "secret/data/team/app"},
+		{name: "leading slash", path: "/secret/data/app", endpoint: "metadata", want: "secret/metadata/app"},
+		{name: "mount containing data", path: "team/data/data/app", endpoint: "metadata", want: "team/data/metadata/app"},
+		{name: "secret named data", path: "secret/data/data", endpoint: "metadata", want: "secret/metadata/data"},
+		{name: "root allowed", path: "secret/data/", endpoint: "metadata", allowRoot: true, want: "secret/metadata/"},
+		{name: "root rejected", path: "secret/data/", endpoint: "metadata", wantErr: ErrInvalidPath},
+		{name: "no section", path: "secret/app", endpoint: "metadata", wantErr: ErrInvalidPath},
+		{name: "KV v1 mount", path: "kv/app", endpoint: "metadata", wantErr: ErrNotKVv2},
+		{name: "not a KV mount", path: "transit/data/app", endpoint: "metadata", wantErr: ErrInvalidPath},
+		{name: "resolved per path", fake: &fakeVault{mountsDenied: true}, path: "team/data/data/app", endpoint: "metadata", want: "team/data/metadata/app"},
+		// With no way to resolve the mount, the path is split on its first "/data/".
+		{name: "unresolved mount", fake: &fakeVault{mountsDenied: true, uiMountsDenied: true}, path: "secret/data/app", endpoint: "destroy", want: "secret/destroy/app"},
+		{name: "unresolved mount without data", fake: &fakeVault{mountsDenied: true, uiMountsDenied: true}, path: "secret/app", endpoint: "destroy", wantErr: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := tt.fake
+			if fake == nil {
+				fake = &fakeVault{}
+			}
+			vc := newTestClient(t, fake)
+			got, err := vc.kvPath(context.Background(), tt.path, tt.endpoint, tt.allowRoot)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("kvPath = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
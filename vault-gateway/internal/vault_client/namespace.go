@@ -0,0 +1,28 @@
+package vault_client
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault/api"
+)
+
+type namespaceKey struct{}
+
+// WithNamespace returns a context whose Vault requests are sent to the given Vault Enterprise
+// namespace. An empty namespace means the namespace of the gateway's token.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+func namespaceFromContext(ctx context.Context) string {
+	namespace, _ := ctx.Value(namespaceKey{}).(string)
+	return namespace
+}
+
+// client returns the Vault client to use for a request, scoped to the namespace carried by ctx.
+func (vc *VaultClient) client(ctx context.Context) *api.Client {
+	if namespace := namespaceFromContext(ctx); namespace != "" {
+		return vc.vaultClient.WithNamespace(namespace)
+	}
+	return vc.vaultClient
+}
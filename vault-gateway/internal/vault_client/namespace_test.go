@@ -0,0 +1,38 @@
+package vault_client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// Requests carry the namespace of their context, and the mounts are cached per namespace.
+func TestNamespaceForwarding(t *testing.T) {
+	fake := &fakeVault{}
+	vc := newTestClient(t, fake)
+	data := map[string]interface{}{"data": map[string]interface{}{"password": "x"}}
+
+	if _, err := vc.WriteSecret(WithNamespace(context.Background(), "team-a"), "secret/data/app", data); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vc.WriteSecret(WithNamespace(context.Background(), "team-a"), "secret/data/other", data); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vc.WriteSecret(context.Background(), "secret/data/app", data); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"GET /v1/sys/mounts ns=team-a",
+		"PUT /v1/secret/data/app ns=team-a",
+		"PUT /v1/secret/data/other ns=team-a",
+		"GET /v1/sys/mounts",
+		"PUT /v1/secret/data/app",
+	}
+	if !reflect.DeepEqual(fake.requests, want) {
+		t.Errorf("requests = %v, want %v", fake.requests, want)
+	}
+	if namespace := vc.vaultClient.Namespace(); namespace != "" {
+		t.Errorf("shared client namespace = %q, want it unchanged", namespace)
+	}
+}
@@ -0,0 +1,76 @@
+package vault_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// IssueCertificate issues a certificate and private key from the PKI role. Vault does not keep the
+// private key, so the returned secret is the only copy of it.
+func (vc *VaultClient) IssueCertificate(ctx context.Context, mount, role string, data map[string]interface{}) (*api.Secret, error) {
+	path, err := enginePath(mount, "issue", role)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := vc.client(ctx).Logical().WriteWithContext(ctx, path, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue certificate at path %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no response from Vault after issuing certificate at path %s", path)
+	}
+	return secret, nil
+}
+
+// RevokeCertificate revokes the certificate with the given serial number and rotates the CRL of the
+// PKI engine mounted at mount.
+func (vc *VaultClient) RevokeCertificate(ctx context.Context, mount, serialNumber string) (*api.Secret, error) {
+	path, err := enginePath(mount, "revoke", "")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := vc.client(ctx).Logical().WriteWithContext(ctx, path, map[string]interface{}{"serial_number": serialNumber})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke certificate %s at path %s: %w", serialNumber, path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no response from Vault after revoking certificate %s", serialNumber)
+	}
+	return secret, nil
+}
+
+// ReadPKICertificate reads a PEM document published by the PKI engine, such as "ca" for the CA
+// certificate, "crl" for the current CRL or a serial number for an issued certificate.
+func (vc *VaultClient) ReadPKICertificate(ctx context.Context, mount, name string) (string, error) {
+	path, err := enginePath(mount, "cert", name)
+	if err != nil {
+		return "", err
+	}
+	secret, err := vc.client(ctx).Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s certificate at path %s: %w", name, path, err)
+	}
+	if secret == nil {
+		return "", fmt.Errorf("%w: no certificate found at path %s", ErrNotFound, path)
+	}
+	certificate, _ := secret.Data["certificate"].(string)
+	return certificate, nil
+}
+
+// ReadPKIRole reads the configuration of a PKI role, such as its allowed domains and SAN options.
+func (vc *VaultClient) ReadPKIRole(ctx context.Context, mount, role string) (map[string]interface{}, error) {
+	path, err := enginePath(mount, "roles", role)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := vc.client(ctx).Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PKI role at path %s: %w", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%w: no PKI role found at path %s", ErrNotFound, path)
+	}
+	return secret.Data, nil
+}
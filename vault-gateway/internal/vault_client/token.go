@@ -0,0 +1,128 @@
+package vault_client
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	reloginMinBackoff = time.Second
+	reloginMaxBackoff = time.Minute
+)
+
+// clock is the time source of the re-login backoff; tests replace it to run without sleeping.
+type clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// OnHealthChange registers handler to be called whenever the Vault token becomes usable or
+// unusable. The handler is called right away with the current state.
+func (vc *VaultClient) OnHealthChange(handler func(healthy bool)) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	vc.healthHandler = handler
+	handler(vc.healthy)
+}
+
+func (vc *VaultClient) setHealthy(healthy bool) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	if vc.healthy == healthy {
+		return
+	}
+	vc.healthy = healthy
+	if vc.healthHandler != nil {
+		vc.healthHandler(healthy)
+	}
+}
+
+// manageTokenLifecycle keeps the Vault token alive: it renews the token while Vault allows it and,
+// once the token reaches its max TTL or renewal stops working, logs in again.
+func (vc *VaultClient) manageTokenLifecycle(ctx context.Context, authInfo *api.Secret) {
+	for {
+		vc.watchToken(ctx, authInfo)
+		if ctx.Err() != nil {
+			return
+		}
+		authInfo = vc.relogin(ctx)
+		if authInfo == nil {
+			return
+		}
+	}
+}
+
+// watchToken renews the token until the lifetime watcher gives up or ctx is done.
+func (vc *VaultClient) watchToken(ctx context.Context, token *api.Secret) {
+	if token.Auth != nil && token.Auth.LeaseDuration == 0 && !token.Auth.Renewable {
+		// Tokens without a TTL (e.g. from a token file) never expire, so there is nothing to renew.
+		slog.Info("Vault token has no TTL, renewal watcher not started")
+		<-ctx.Done()
+		return
+	}
+	watcher, err := vc.vaultClient.NewLifetimeWatcher(&api.LifetimeWatcherInput{
+		Secret:    token,
+		Increment: 3600,
+	})
+	if err != nil {
+		slog.Error("Failed to create lifetime watcher", "error", err)
+		return
+	}
+
+	slog.Info("Starting Vault token renewal watcher")
+	go watcher.Start()
+	defer watcher.Stop()
+	for {
+		select {
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				slog.Error("Vault token renewal watcher stopped with error", "error", err)
+			} else {
+				slog.Info("Vault token reached the end of its lifetime")
+			}
+			return
+		case renewal := <-watcher.RenewCh():
+			if renewal != nil {
+				slog.Info("Vault token renewed", "lease_id", renewal.Secret.LeaseID, "renewable", renewal.Secret.Renewable, "lease_duration", renewal.Secret.LeaseDuration)
+			} else {
+				slog.Info("Vault token renewed with no additional information")
+			}
+		case <-ctx.Done():
+			slog.Info("Context done, stopping Vault token renewal watcher")
+			return
+		}
+	}
+}
+
+// relogin logs in again with exponential backoff until it succeeds or ctx is done, in which case
+// it returns nil. The client is reported unhealthy while login keeps failing.
+func (vc *VaultClient) relogin(ctx context.Context) *api.Secret {
+	backoff := reloginMinBackoff
+	for {
+		authInfo, err := vc.vaultClient.Auth().Login(ctx, vc.authMethod)
+		if err == nil {
+			slog.Info("Vault re-login successful")
+			vc.setHealthy(true)
+			return authInfo
+		}
+		vc.setHealthy(false)
+		wait := backoff + rand.N(backoff/5)
+		slog.Error("Vault re-login failed", "error", err, "retry_in", wait.String())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-vc.clock.After(wait):
+		}
+		backoff = min(backoff*2, reloginMaxBackoff)
+	}
+}
@@ -0,0 +1,205 @@
+package vault_client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// fakeAuth answers each login with the next scripted result.
+type fakeAuth struct {
+	mu      sync.Mutex
+	results []fakeLogin
+	logins  int
+}
+
+type fakeLogin struct {
+	token *api.Secret
+	err   error
+}
+
+func (a *fakeAuth) Login(context.Context, *api.Client) (*api.Secret, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.logins++
+	if len(a.results) == 0 {
+		return nil, errors.New("no more scripted logins")
+	}
+	result := a.results[0]
+	a.results = a.results[1:]
+	return result.token, result.err
+}
+
+// token returns a login result for a token with the given TTL. A zero TTL never expires.
+func token(clientToken string, ttl int) fakeLogin {
+	return fakeLogin{token: &api.Secret{Auth: &api.SecretAuth{ClientToken: clientToken, LeaseDuration: ttl}}}
+}
+
+var errLoginDenied = errors.New("permission denied")
+
+// fakeClock hands every wait to the test, which fires it or cancels the context instead.
+type fakeClock struct {
+	waits chan fakeTimer
+}
+
+type fakeTimer struct {
+	duration time.Duration
+	fire     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{waits: make(chan fakeTimer)}
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	timer := fakeTimer{duration: d, fire: make(chan time.Time, 1)}
+	c.waits <- timer
+	return timer.fire
+}
+
+func (c *fakeClock) next(t *testing.T) fakeTimer {
+	t.Helper()
+	select {
+	case timer := <-c.waits:
+		return timer
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a re-login backoff")
+		return fakeTimer{}
+	}
+}
+
+// healthRecorder collects the states reported to OnHealthChange.
+type healthRecorder struct {
+	states chan bool
+}
+
+func recordHealth(vc *VaultClient) *healthRecorder {
+	recorder := &healthRecorder{states: make(chan bool, 16)}
+	vc.OnHealthChange(func(healthy bool) { recorder.states <- healthy })
+	return recorder
+}
+
+func (r *healthRecorder) next(t *testing.T) bool {
+	t.Helper()
+	select {
+	case healthy := <-r.states:
+		return healthy
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a health change")
+		return false
+	}
+}
+
+func newLifecycleClient(t *testing.T) *api.Client {
+	t.Helper()
+	client, err := api.NewClient(&api.Config{Address: "http://127.0.0.1:1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// When the token reaches the end of its lifetime and the next logins fail, the client reports
+// itself unhealthy, retries with a growing backoff and becomes healthy again with the new token.
+func TestTokenLifecycleRelogin(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth := &fakeAuth{results: []fakeLogin{
+		token("s.first", 1),
+		{err: errLoginDenied},
+		{err: errLoginDenied},
+		token("s.second", 0),
+	}}
+	clock := newFakeClock()
+	client := newLifecycleClient(t)
+
+	vc, err := newVaultClient(ctx, client, auth, clock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	health := recordHealth(vc)
+	if !health.next(t) {
+		t.Fatal("client is unhealthy right after login")
+	}
+
+	// The first token expires after one second; both failed logins wait before retrying.
+	first := clock.next(t)
+	if healthy := health.next(t); healthy {
+		t.Error("client stayed healthy after a failed re-login")
+	}
+	first.fire <- time.Time{}
+	second := clock.next(t)
+	second.fire <- time.Time{}
+	if healthy := health.next(t); !healthy {
+		t.Error("client did not become healthy after re-login")
+	}
+
+	if first.duration < reloginMinBackoff || first.duration >= reloginMinBackoff*6/5 {
+		t.Errorf("first backoff = %v, want %v plus up to 20%% jitter", first.duration, reloginMinBackoff)
+	}
+	if second.duration < 2*reloginMinBackoff || second.duration >= 2*reloginMinBackoff*6/5 {
+		t.Errorf("second backoff = %v, want %v plus up to 20%% jitter", second.duration, 2*reloginMinBackoff)
+	}
+	if client.Token() != "s.second" {
+		t.Errorf("client token = %q, want the token of the re-login", client.Token())
+	}
+	select {
+	case healthy := <-health.states:
+		t.Errorf("unexpected health change to %v", healthy)
+	default:
+	}
+}
+
+func TestReloginBackoffIsCapped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	auth := &fakeAuth{}
+	clock := newFakeClock()
+	vc := &VaultClient{vaultClient: newLifecycleClient(t), authMethod: auth, clock: clock, healthy: true}
+
+	done := make(chan *api.Secret)
+	go func() { done <- vc.relogin(ctx) }()
+
+	var base []time.Duration
+	for range 9 {
+		timer := clock.next(t)
+		// Strip the jitter, which is under a fifth of the base backoff.
+		base = append(base, timer.duration*5/6)
+		timer.fire <- time.Time{}
+	}
+	want := []time.Duration{1, 2, 4, 8, 16, 32, 60, 60, 60}
+	for i, seconds := range want {
+		low, high := seconds*time.Second*5/6, seconds*time.Second
+		if base[i] < low || base[i] > high {
+			t.Errorf("backoff %d = %v (without jitter), want about %v", i, base[i], seconds*time.Second)
+		}
+	}
+
+	// Cancelling the context while waiting stops re-login without a token.
+	clock.next(t)
+	cancel()
+	if authInfo := <-done; authInfo != nil {
+		t.Errorf("relogin returned %v after cancel, want nil", authInfo)
+	}
+	if vc.healthy {
+		t.Error("client reported healthy while logins were failing")
+	}
+}
+
+func TestOnHealthChange(t *testing.T) {
+	vc := &VaultClient{healthy: true}
+	var states []bool
+	vc.OnHealthChange(func(healthy bool) { states = append(states, healthy) })
+	vc.setHealthy(true)
+	vc.setHealthy(false)
+	vc.setHealthy(false)
+	vc.setHealthy(true)
+	if want := []bool{true, false, true}; !reflect.DeepEqual(states, want) {
+		t.Errorf("states = %v, want %v", states, want)
+	}
+}
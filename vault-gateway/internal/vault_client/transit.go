@@ -0,0 +1,32 @@
+package vault_client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Transit runs a transit engine operation (encrypt, decrypt, rewrap, sign or verify) with key on
+// the engine mounted at mount. The body must carry a batch_input list; the batch_results Vault
+// returns are passed back in the same order. Items that fail individually are reported in their
+// own "error" field instead of failing the whole request.
+func (vc *VaultClient) Transit(ctx context.Context, mount, operation, key string, body map[string]interface{}) ([]interface{}, error) {
+	path, err := enginePath(mount, operation, key)
+	if err != nil {
+		return nil, err
+	}
+	body["partial_failure_response_code"] = http.StatusOK
+
+	secret, err := vc.client(ctx).Logical().WriteWithContext(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s with transit key at path %s: %w", operation, path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no response from Vault for transit %s at path %s", operation, path)
+	}
+	results, ok := secret.Data["batch_results"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected transit %s response from Vault at path %s", operation, path)
+	}
+	return results, nil
+}
@@ -0,0 +1,133 @@
+package vault_client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// WalkFunc is called for every secret found by Walk, and for every folder that could not be listed
+// (with a path ending in "/" and a non-nil err). It may be called from several goroutines at once.
+// Returning an error stops the walk.
+type WalkFunc func(path string, err error) error
+
+// Walk lists the tree under root recursively, running at most concurrency Vault requests (listings
+// and visit calls) at a time. On KV v2 mounts root may be a data or metadata path and secrets are
+// reported by their data path. Only a failure to list root itself is returned as an error.
+func (vc *VaultClient) Walk(ctx context.Context, root string, concurrency int, visit WalkFunc) error {
+	if dataRoot, err := vc.kvPath(ctx, root, "data", true); err == nil {
+		root = dataRoot
+	} else if !errors.Is(err, ErrNotKVv2) {
+		return err
+	}
+	if !strings.HasSuffix(root, "/") {
+		root += "/"
+	}
+	keys, err := vc.listKeys(ctx, root)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := &walker{vc: vc, ctx: ctx, cancel: cancel, visit: visit, slots: make(chan struct{}, max(concurrency, 1))}
+	w.wg.Add(1)
+	go w.folder(root, keys)
+	w.wg.Wait()
+	if w.err != nil {
+		return w.err
+	}
+	return ctx.Err()
+}
+
+type walker struct {
+	vc     *VaultClient
+	ctx    context.Context
+	cancel context.CancelFunc
+	visit  WalkFunc
+	slots  chan struct{}
+	wg     sync.WaitGroup
+
+	errOnce sync.Once
+	err     error
+}
+
+// folder visits the secrets in a listed folder and walks its subfolders, each in its own goroutine.
+// A goroutine holds a slot only while it talks to Vault, never while waiting for its children.
+func (w *walker) folder(path string, keys []string) {
+	defer w.wg.Done()
+	for _, key := range keys {
+		if strings.HasSuffix(key, "/") {
+			w.wg.Add(1)
+			go w.subfolder(path + key)
+			continue
+		}
+		if !w.acquire() {
+			return
+		}
+		err := w.visit(path+key, nil)
+		w.release()
+		if err != nil {
+			w.fail(err)
+			return
+		}
+	}
+}
+
+func (w *walker) subfolder(path string) {
+	if !w.acquire() {
+		w.wg.Done()
+		return
+	}
+	keys, err := w.vc.listKeys(w.ctx, path)
+	if err != nil {
+		err = w.visit(path, err)
+	}
+	w.release()
+	if err != nil {
+		w.fail(err)
+		w.wg.Done()
+		return
+	}
+	w.folder(path, keys)
+}
+
+func (w *walker) acquire() bool {
+	select {
+	case w.slots <- struct{}{}:
+		if w.ctx.Err() != nil {
+			w.release()
+			return false
+		}
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func (w *walker) release() {
+	<-w.slots
+}
+
+func (w *walker) fail(err error) {
+	w.errOnce.Do(func() {
+		w.err = err
+		w.cancel()
+	})
+}
+
+func (vc *VaultClient) listKeys(ctx context.Context, path string) ([]string, error) {
+	secret, err := vc.List(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	values, _ := secret.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		if key, ok := value.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
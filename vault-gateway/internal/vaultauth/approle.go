@@ -0,0 +1,116 @@
+package vaultauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// AppRoleAuth logs in with a role ID and a secret ID given directly or read from a file on every login.
+// When the secret ID is a response-wrapping token, the unwrapped secret ID is kept in memory: a wrapping
+// token can only be unwrapped once, so the file is read and unwrapped again only after Vault rejects
+// the cached secret ID.
+type AppRoleAuth struct {
+	mountPath    string
+	roleID       string
+	secretID     string
+	secretIDFile string
+	wrapped      bool
+
+	mu       sync.Mutex
+	unwrapID string
+}
+
+// NewAppRoleAuth logs in with the approle auth method. Exactly one of secretID and secretIDFile is set.
+func NewAppRoleAuth(roleID, secretID, secretIDFile string, wrapped bool, mountPath string) *AppRoleAuth {
+	if mountPath == "" {
+		mountPath = "approle"
+	}
+	return &AppRoleAuth{
+		mountPath:    mountPath,
+		roleID:       roleID,
+		secretID:     secretID,
+		secretIDFile: secretIDFile,
+		wrapped:      wrapped,
+	}
+}
+
+func (a *AppRoleAuth) Login(ctx context.Context, client *api.Client) (*api.Secret, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.unwrapID != "" {
+		secret, err := a.login(ctx, client, a.unwrapID)
+		if err == nil || !isLoginRejected(err) {
+			return secret, err
+		}
+		slog.Warn("Vault rejected the cached AppRole secret ID, unwrapping a new one", "error", err)
+		a.unwrapID = ""
+	}
+
+	secretID := a.secretID
+	if a.secretIDFile != "" {
+		var err error
+		if secretID, err = readTokenFile(a.secretIDFile); err != nil {
+			return nil, err
+		}
+	}
+	if a.wrapped {
+		unwrapped, err := unwrapSecretID(ctx, client, secretID)
+		if err != nil {
+			return nil, err
+		}
+		// Cached before logging in: the wrapping token is spent now, even if the login below fails.
+		a.unwrapID = unwrapped
+		secretID = unwrapped
+	}
+	return a.login(ctx, client, secretID)
+}
+
+func (a *AppRoleAuth) login(ctx context.Context, client *api.Client, secretID string) (*api.Secret, error) {
+	path := "auth/" + strings.Trim(a.mountPath, "/") + "/login"
+	secret, err := client.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		"role_id":   a.roleID,
+		"secret_id": secretID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in at %s: %w", path, err)
+	}
+	return secret, nil
+}
+
+// unwrapSecretID unwraps on a clone without a token: during a re-login the shared client still holds
+// the old, possibly expired token, which Vault would check instead of the wrapping token, and with no
+// token at all Unwrap would set the wrapping token on the shared client.
+func unwrapSecretID(ctx context.Context, client *api.Client, wrappingToken string) (string, error) {
+	unwrapClient, err := client.Clone()
+	if err != nil {
+		return "", fmt.Errorf("failed to clone Vault client: %w", err)
+	}
+	unwrapClient.ClearToken()
+	unwrapped, err := unwrapClient.Logical().UnwrapWithContext(ctx, wrappingToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to unwrap AppRole secret ID: %w", err)
+	}
+	if unwrapped == nil {
+		return "", errors.New("failed to unwrap AppRole secret ID: empty response")
+	}
+	secretID, _ := unwrapped.Data["secret_id"].(string)
+	if secretID == "" {
+		return "", errors.New("wrapped response does not contain a secret_id")
+	}
+	return secretID, nil
+}
+
+// isLoginRejected tells a rejected secret ID apart from failures worth retrying with the same one,
+// such as Vault being sealed or unreachable.
+func isLoginRejected(err error) bool {
+	var respErr *api.ResponseError
+	return errors.As(err, &respErr) && (respErr.StatusCode == http.StatusBadRequest || respErr.StatusCode == http.StatusForbidden)
+}
@@ -0,0 +1,232 @@
+package vaultauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// fakeVault implements the Vault endpoints used by the auth methods. Wrapping tokens can be unwrapped
+// once, like in Vault.
+type fakeVault struct {
+	t *testing.T
+
+	mu          sync.Mutex
+	wrapped     map[string]string // wrapping token -> secret ID
+	secretIDs   map[string]bool
+	tokens      map[string]int // token -> TTL in seconds, for lookup-self
+	loginStatus int            // when set, the next login fails with this status
+	unwraps     int
+	logins      []map[string]interface{}
+	loginPaths  []string
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *api.Client) {
+	t.Helper()
+	fv := &fakeVault{t: t, wrapped: map[string]string{}, secretIDs: map[string]bool{}, tokens: map[string]int{}}
+	server := httptest.NewServer(fv)
+	t.Cleanup(server.Close)
+
+	client, err := api.NewClient(&api.Config{Address: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.ClearToken()
+	return fv, client
+}
+
+func (fv *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+	switch {
+	case r.URL.Path == "/v1/sys/wrapping/unwrap":
+		fv.unwraps++
+		// Like Vault, a wrapping token sent in the body needs a valid client token in the header.
+		token, _ := body["token"].(string)
+		if token == "" {
+			token = r.Header.Get("X-Vault-Token")
+		} else if _, valid := fv.tokens[r.Header.Get("X-Vault-Token")]; !valid {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		secretID, ok := fv.wrapped[token]
+		if !ok {
+			writeVaultError(w, http.StatusBadRequest, "wrapping token is not valid or does not exist")
+			return
+		}
+		delete(fv.wrapped, token)
+		writeVaultJSON(w, map[string]interface{}{"data": map[string]interface{}{"secret_id": secretID}})
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/auth/token/lookup-self":
+		token := r.Header.Get("X-Vault-Token")
+		ttl, ok := fv.tokens[token]
+		if !ok {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		writeVaultJSON(w, map[string]interface{}{"data": map[string]interface{}{
+			"id": token, "ttl": ttl, "renewable": true, "policies": []string{"default", "gateway"},
+		}})
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		fv.loginPaths = append(fv.loginPaths, r.URL.Path)
+		fv.logins = append(fv.logins, body)
+		if fv.loginStatus != 0 {
+			code := fv.loginStatus
+			fv.loginStatus = 0
+			writeVaultError(w, code, "login failed")
+			return
+		}
+		if secretID, ok := body["secret_id"].(string); ok && !fv.secretIDs[secretID] {
+			writeVaultError(w, http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+		writeVaultJSON(w, map[string]interface{}{"auth": map[string]interface{}{
+			"client_token": "s.login-token", "lease_duration": 3600, "renewable": true,
+		}})
+	default:
+		fv.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+	}
+}
+
+func (fv *fakeVault) wrap(wrappingToken, secretID string) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+	fv.wrapped[wrappingToken] = secretID
+	fv.secretIDs[secretID] = true
+}
+
+func (fv *fakeVault) revoke(secretID string) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+	delete(fv.secretIDs, secretID)
+}
+
+func (fv *fakeVault) counts() (unwraps, logins int) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+	return fv.unwraps, len(fv.logins)
+}
+
+func writeVaultJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeVaultError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string][]string{"errors": {message}})
+}
+
+func writeTokenFile(t *testing.T, path, token string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAppRoleWrappedSecretIDIsUnwrappedOnce(t *testing.T) {
+	fv, client := newFakeVault(t)
+	file := filepath.Join(t.TempDir(), "secret-id")
+	fv.wrap("wrap-1", "secret-1")
+	writeTokenFile(t, file, "wrap-1")
+	auth := NewAppRoleAuth("role-1", "", file, true, "")
+	ctx := context.Background()
+
+	// The first login unwraps with a client that has no token yet.
+	for i := 0; i < 3; i++ {
+		secret, err := auth.Login(ctx, client)
+		if err != nil {
+			t.Fatalf("login %d: %v", i+1, err)
+		}
+		if secret.Auth.ClientToken != "s.login-token" {
+			t.Fatalf("login %d: token = %q", i+1, secret.Auth.ClientToken)
+		}
+	}
+	if client.Token() != "" {
+		t.Errorf("client token = %q, the unwrap must not set the wrapping token on it", client.Token())
+	}
+	if unwraps, logins := fv.counts(); unwraps != 1 || logins != 3 {
+		t.Fatalf("unwraps = %d, logins = %d, want 1 and 3", unwraps, logins)
+	}
+	if got := fv.logins[2]; got["role_id"] != "role-1" || got["secret_id"] != "secret-1" {
+		t.Errorf("login body = %v", got)
+	}
+	if fv.loginPaths[0] != "/v1/auth/approle/login" {
+		t.Errorf("login path = %s", fv.loginPaths[0])
+	}
+
+	// A transient failure keeps the cached secret ID.
+	fv.mu.Lock()
+	fv.loginStatus = http.StatusServiceUnavailable
+	fv.mu.Unlock()
+	if _, err := auth.Login(ctx, client); err == nil {
+		t.Fatal("login during outage succeeded")
+	}
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("login after outage: %v", err)
+	}
+	if unwraps, _ := fv.counts(); unwraps != 1 {
+		t.Fatalf("unwraps = %d after a transient failure, want 1", unwraps)
+	}
+
+	// Once the secret ID is revoked and the file still holds the spent wrapping token, login fails.
+	fv.revoke("secret-1")
+	if _, err := auth.Login(ctx, client); err == nil {
+		t.Fatal("login with a revoked secret ID and a spent wrapping token succeeded")
+	}
+
+	// A new wrapping token written to the file is unwrapped and used, even though the client still
+	// holds the expired token of the previous login.
+	fv.wrap("wrap-2", "secret-2")
+	writeTokenFile(t, file, "wrap-2")
+	client.SetToken("s.expired")
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("login with a new wrapping token: %v", err)
+	}
+	if client.Token() != "s.expired" {
+		t.Errorf("client token = %q, the unwrap must not change it", client.Token())
+	}
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("login with the cached new secret ID: %v", err)
+	}
+	if got := fv.logins[len(fv.logins)-1]["secret_id"]; got != "secret-2" {
+		t.Errorf("secret_id = %v, want secret-2", got)
+	}
+}
+
+func TestAppRoleUnwrappedSecretIDIsNotCached(t *testing.T) {
+	fv, client := newFakeVault(t)
+	fv.secretIDs["secret-1"] = true
+	fv.secretIDs["secret-2"] = true
+	file := filepath.Join(t.TempDir(), "secret-id")
+	writeTokenFile(t, file, "secret-1")
+	auth := NewAppRoleAuth("role-1", "", file, false, "custom-approle")
+	ctx := context.Background()
+
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	writeTokenFile(t, file, "secret-2")
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("Login after rotation: %v", err)
+	}
+	if got := fv.logins[1]["secret_id"]; got != "secret-2" {
+		t.Errorf("secret_id = %v, want the rotated secret-2", got)
+	}
+	if fv.loginPaths[0] != "/v1/auth/custom-approle/login" || fv.unwraps != 0 {
+		t.Errorf("login path = %s, unwraps = %d", fv.loginPaths[0], fv.unwraps)
+	}
+}
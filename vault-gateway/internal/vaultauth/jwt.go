@@ -0,0 +1,70 @@
+package vaultauth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// DefaultServiceAccountTokenFile is where Kubernetes mounts the pod's service account token.
+const DefaultServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// JWTAuth logs in by presenting a JWT read from a file to a Vault role. The kubernetes and jwt auth
+// methods share the same login API and differ only in their default mount and token file. The file
+// is read on every login, so rotated tokens (such as projected service account tokens) are picked up.
+type JWTAuth struct {
+	mountPath string
+	role      string
+	tokenFile string
+}
+
+// NewKubernetesAuth logs in with the kubernetes auth method, using the pod's service account token
+// unless tokenFile is set.
+func NewKubernetesAuth(role, tokenFile, mountPath string) *JWTAuth {
+	if tokenFile == "" {
+		tokenFile = DefaultServiceAccountTokenFile
+	}
+	if mountPath == "" {
+		mountPath = "kubernetes"
+	}
+	return &JWTAuth{mountPath: mountPath, role: role, tokenFile: tokenFile}
+}
+
+// NewJWTAuth logs in with the jwt auth method.
+func NewJWTAuth(role, tokenFile, mountPath string) *JWTAuth {
+	if mountPath == "" {
+		mountPath = "jwt"
+	}
+	return &JWTAuth{mountPath: mountPath, role: role, tokenFile: tokenFile}
+}
+
+func (a *JWTAuth) Login(ctx context.Context, client *api.Client) (*api.Secret, error) {
+	jwt, err := readTokenFile(a.tokenFile)
+	if err != nil {
+		return nil, err
+	}
+	path := "auth/" + strings.Trim(a.mountPath, "/") + "/login"
+	secret, err := client.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		"role": a.role,
+		"jwt":  jwt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in at %s: %w", path, err)
+	}
+	return secret, nil
+}
+
+func readTokenFile(file string) (string, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", file)
+	}
+	return token, nil
+}
@@ -0,0 +1,87 @@
+package vaultauth
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestJWTAuthLogin(t *testing.T) {
+	fv, client := newFakeVault(t)
+	file := filepath.Join(t.TempDir(), "jwt")
+	writeTokenFile(t, file, "jwt-1")
+	auth := NewJWTAuth("gateway", file, "")
+	ctx := context.Background()
+
+	secret, err := auth.Login(ctx, client)
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if secret.Auth.ClientToken != "s.login-token" {
+		t.Fatalf("token = %q", secret.Auth.ClientToken)
+	}
+	if fv.loginPaths[0] != "/v1/auth/jwt/login" {
+		t.Errorf("login path = %s", fv.loginPaths[0])
+	}
+	if got := fv.logins[0]; got["role"] != "gateway" || got["jwt"] != "jwt-1" {
+		t.Errorf("login body = %v", got)
+	}
+
+	// The file is read on every login, so a rotated token is used for the next one.
+	writeTokenFile(t, file, "jwt-2")
+	if _, err := auth.Login(ctx, client); err != nil {
+		t.Fatalf("Login after rotation: %v", err)
+	}
+	if got := fv.logins[1]["jwt"]; got != "jwt-2" {
+		t.Errorf("jwt = %v, want the rotated jwt-2", got)
+	}
+}
+
+func TestKubernetesAuthLogin(t *testing.T) {
+	fv, client := newFakeVault(t)
+	file := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, file, "sa-token")
+
+	if _, err := NewKubernetesAuth("gateway", file, "").Login(context.Background(), client); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if _, err := NewKubernetesAuth("gateway", file, "/k8s-prod/").Login(context.Background(), client); err != nil {
+		t.Fatalf("Login with a custom mount: %v", err)
+	}
+	if fv.loginPaths[0] != "/v1/auth/kubernetes/login" || fv.loginPaths[1] != "/v1/auth/k8s-prod/login" {
+		t.Errorf("login paths = %v", fv.loginPaths)
+	}
+	if got := fv.logins[0]; got["role"] != "gateway" || got["jwt"] != "sa-token" {
+		t.Errorf("login body = %v", got)
+	}
+	if auth := NewKubernetesAuth("gateway", "", ""); auth.tokenFile != DefaultServiceAccountTokenFile {
+		t.Errorf("token file = %s, want the service account token", auth.tokenFile)
+	}
+}
+
+func TestJWTAuthLoginErrors(t *testing.T) {
+	fv, client := newFakeVault(t)
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	writeTokenFile(t, empty, "  ")
+	valid := filepath.Join(dir, "jwt")
+	writeTokenFile(t, valid, "jwt-1")
+
+	if _, err := NewJWTAuth("gateway", filepath.Join(dir, "missing"), "").Login(context.Background(), client); err == nil {
+		t.Error("login with a missing token file succeeded")
+	}
+	if _, err := NewJWTAuth("gateway", empty, "").Login(context.Background(), client); err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Errorf("login with an empty token file: err = %v", err)
+	}
+	if len(fv.logins) != 0 {
+		t.Errorf("logins = %d without a usable token, want 0", len(fv.logins))
+	}
+
+	fv.mu.Lock()
+	fv.loginStatus = 403
+	fv.mu.Unlock()
+	if _, err := NewJWTAuth("gateway", valid, "").Login(context.Background(), client); err == nil || !strings.Contains(err.Error(), "auth/jwt/login") {
+		t.Errorf("rejected login: err = %v", err)
+	}
+}
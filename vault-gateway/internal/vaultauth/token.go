@@ -0,0 +1,52 @@
+package vaultauth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// TokenFileAuth uses a Vault token that something else (e.g. Vault Agent) keeps up to date in a
+// file. Logging in re-reads the file and looks the token up to learn its TTL.
+type TokenFileAuth struct {
+	tokenFile string
+}
+
+func NewTokenFileAuth(tokenFile string) *TokenFileAuth {
+	return &TokenFileAuth{tokenFile: tokenFile}
+}
+
+func (a *TokenFileAuth) Login(ctx context.Context, client *api.Client) (*api.Secret, error) {
+	token, err := readTokenFile(a.tokenFile)
+	if err != nil {
+		return nil, err
+	}
+	lookupClient, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone Vault client: %w", err)
+	}
+	lookupClient.SetToken(token)
+	lookup, err := lookupClient.Auth().Token().LookupSelfWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up token from %s: %w", a.tokenFile, err)
+	}
+
+	ttl, err := lookup.TokenTTL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token TTL: %w", err)
+	}
+	renewable, err := lookup.TokenIsRenewable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token renewability: %w", err)
+	}
+	policies, _ := lookup.TokenPolicies()
+	return &api.Secret{
+		Auth: &api.SecretAuth{
+			ClientToken:   token,
+			Policies:      policies,
+			Renewable:     renewable,
+			LeaseDuration: int(ttl.Seconds()),
+		},
+	}, nil
+}
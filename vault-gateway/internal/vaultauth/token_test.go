@@ -0,0 +1,60 @@
+package vaultauth
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTokenFileAuthLogin(t *testing.T) {
+	fv, client := newFakeVault(t)
+	fv.tokens["s.agent-1"] = 1800
+	fv.tokens["s.agent-2"] = 600
+	file := filepath.Join(t.TempDir(), "token")
+	writeTokenFile(t, file, "s.agent-1")
+	auth := NewTokenFileAuth(file)
+	ctx := context.Background()
+
+	secret, err := auth.Login(ctx, client)
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if secret.Auth.ClientToken != "s.agent-1" || secret.Auth.LeaseDuration != 1800 || !secret.Auth.Renewable {
+		t.Errorf("auth = %+v", secret.Auth)
+	}
+	if !reflect.DeepEqual(secret.Auth.Policies, []string{"default", "gateway"}) {
+		t.Errorf("policies = %v", secret.Auth.Policies)
+	}
+	// The lookup uses a clone, so the client keeps its own token.
+	if client.Token() != "" {
+		t.Errorf("client token = %q, want it untouched", client.Token())
+	}
+
+	// Vault Agent rewrote the file.
+	writeTokenFile(t, file, "s.agent-2")
+	secret, err = auth.Login(ctx, client)
+	if err != nil {
+		t.Fatalf("Login after rotation: %v", err)
+	}
+	if secret.Auth.ClientToken != "s.agent-2" || secret.Auth.LeaseDuration != 600 {
+		t.Errorf("auth after rotation = %+v", secret.Auth)
+	}
+	if len(fv.logins) != 0 {
+		t.Errorf("logins = %d, the token method must not call a login endpoint", len(fv.logins))
+	}
+}
+
+func TestTokenFileAuthRejectedToken(t *testing.T) {
+	_, client := newFakeVault(t)
+	dir := t.TempDir()
+	file := filepath.Join(dir, "token")
+	writeTokenFile(t, file, "s.revoked")
+
+	if _, err := NewTokenFileAuth(file).Login(context.Background(), client); err == nil {
+		t.Error("login with a token Vault does not know succeeded")
+	}
+	if _, err := NewTokenFileAuth(filepath.Join(dir, "missing")).Login(context.Background(), client); err == nil {
+		t.Error("login with a missing token file succeeded")
+	}
+}
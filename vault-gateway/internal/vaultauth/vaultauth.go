@@ -0,0 +1,27 @@
+// Package vaultauth provides the methods the gateway can use to log in to Vault. All of them
+// implement api.AuthMethod, so the Vault client can log in again with the same method whenever its
+// token expires.
+package vaultauth
+
+import (
+	"fmt"
+	"vault-gateway/internal/config"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// New builds the auth method selected by cfg.VaultAuthMethod.
+func New(cfg *config.Config) (api.AuthMethod, error) {
+	switch cfg.VaultAuthMethod {
+	case config.VaultAuthAppRole:
+		return NewAppRoleAuth(cfg.VaultSrvRoleID, cfg.VaultSrvSecretID, cfg.VaultSrvSecretIDFile, cfg.VaultSrvSecretIDWrapped, cfg.VaultAuthMount), nil
+	case config.VaultAuthKubernetes:
+		return NewKubernetesAuth(cfg.VaultAuthRole, cfg.VaultAuthJWTFile, cfg.VaultAuthMount), nil
+	case config.VaultAuthJWT:
+		return NewJWTAuth(cfg.VaultAuthRole, cfg.VaultAuthJWTFile, cfg.VaultAuthMount), nil
+	case config.VaultAuthToken:
+		return NewTokenFileAuth(cfg.VaultTokenFile), nil
+	default:
+		return nil, fmt.Errorf("unknown Vault auth method %q", cfg.VaultAuthMethod)
+	}
+}
@@ -8,13 +8,17 @@ import (
 	"os/signal"
 	"syscall"
 
-	"zabbix-gateway/internal/auth"
+	"shared/gatewayauth"
+	"shared/tlsconfig"
 	"zabbix-gateway/internal/config"
 	"zabbix-gateway/internal/grpcserver"
 	"zabbix-gateway/internal/zabbix_client"
 	monitoring "zabbix-gateway/proto/zabbix"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
@@ -44,14 +48,36 @@ func main() {
 		os.Exit(1)
 	}
 
-	authInterceptor := auth.NewAuthInterceptor(cfg.GatewayAuthToken)
-	gServer := grpc.NewServer(
+	authTokens, err := gatewayauth.NewTokenSet(cfg.GatewayAuthToken, gatewayauth.ParseMethodPatterns(cfg.GatewayAuthMethods), cfg.GatewayAuthFile)
+	if err != nil {
+		slog.Error("Falha ao carregar tokens de autenticação interna", "error", err)
+		os.Exit(1)
+	}
+	go authTokens.Watch(appCtx, gatewayauth.TokenReloadInterval)
+	authInterceptor := gatewayauth.NewAuthInterceptor(authTokens)
+	serverOpts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(authInterceptor.Unary()),
-	)
+		grpc.StreamInterceptor(authInterceptor.Stream()),
+	}
+	if cfg.TLSEnabled {
+		tlsReloader, err := tlsconfig.NewReloader(cfg.TLSCAFile, cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			slog.Error("Falha ao carregar certificados TLS", "error", err)
+			os.Exit(1)
+		}
+		go tlsReloader.Watch(appCtx, tlsconfig.ReloadInterval)
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsReloader.ServerConfig())))
+		slog.Info("mTLS habilitado no servidor gRPC")
+	}
+	gServer := grpc.NewServer(serverOpts...)
 
 	zabbixGrpcServer := grpcserver.NewServer(zabbixClient)
 	monitoring.RegisterMonitoringServiceServer(gServer, zabbixGrpcServer)
 
+	// Serviço de health do gRPC, aberto sem token para as sondas do orquestrador, como nos demais gateways.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(gServer, healthServer)
+
 	go func() {
 		slog.Info("Iniciando servidor gRPC do Zabbix Gateway", "port", ":5555")
 		if err := gServer.Serve(lis); err != nil {
@@ -63,6 +89,7 @@ func main() {
 	<-appCtx.Done()
 	slog.Info("Sinal de shutdown recebido, iniciando desligamento gracioso...")
 
+	healthServer.Shutdown()
 	gServer.GracefulStop()
 
 	slog.Info("Aplicação Zabbix Gateway finalizada.")
@@ -3,19 +3,28 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 )
 
 type Config struct {
-	ZabbixAPIURL     string
-	ZabbixAPIToken   string
-	GatewayAuthToken string
+	ZabbixAPIURL       string
+	ZabbixAPIToken     string
+	GatewayAuthToken   string
+	GatewayAuthFile    string
+	GatewayAuthMethods string
+	TLSEnabled         bool
+	TLSCAFile          string
+	TLSCertFile        string
+	TLSKeyFile         string
 }
 
 func LoadConfig() (*Config, error) {
 	cfg := &Config{
-		ZabbixAPIURL:     os.Getenv("ZABBIX_API_URL"),
-		ZabbixAPIToken:   os.Getenv("ZABBIX_API_TOKEN"),
-		GatewayAuthToken: os.Getenv("INTERNAL_API_AUTH_TOKEN"),
+		ZabbixAPIURL:       os.Getenv("ZABBIX_API_URL"),
+		ZabbixAPIToken:     os.Getenv("ZABBIX_API_TOKEN"),
+		GatewayAuthToken:   os.Getenv("INTERNAL_API_AUTH_TOKEN"),
+		GatewayAuthFile:    os.Getenv("INTERNAL_API_AUTH_TOKENS_FILE"),
+		GatewayAuthMethods: os.Getenv("INTERNAL_API_AUTH_METHODS"),
 	}
 	if cfg.ZabbixAPIURL == "" {
 		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: ZABBIX_API_URL")
@@ -23,8 +32,17 @@ func LoadConfig() (*Config, error) {
 	if cfg.ZabbixAPIToken == "" {
 		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: ZABBIX_API_TOKEN")
 	}
-	if cfg.GatewayAuthToken == "" {
-		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: INTERNAL_API_AUTH_TOKEN")
+	if cfg.GatewayAuthToken == "" && cfg.GatewayAuthFile == "" {
+		return nil, fmt.Errorf("variável de ambiente obrigatória não definida: INTERNAL_API_AUTH_TOKEN ou INTERNAL_API_AUTH_TOKENS_FILE")
+	}
+	cfg.TLSEnabled, _ = strconv.ParseBool(os.Getenv("GRPC_TLS_ENABLED"))
+	if cfg.TLSEnabled {
+		cfg.TLSCAFile = os.Getenv("GRPC_TLS_CA_FILE")
+		cfg.TLSCertFile = os.Getenv("GRPC_TLS_CERT_FILE")
+		cfg.TLSKeyFile = os.Getenv("GRPC_TLS_KEY_FILE")
+		if cfg.TLSCAFile == "" || cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, fmt.Errorf("variáveis de ambiente obrigatórias com GRPC_TLS_ENABLED: GRPC_TLS_CA_FILE, GRPC_TLS_CERT_FILE e GRPC_TLS_KEY_FILE")
+		}
 	}
 	return cfg, nil
 }